@@ -0,0 +1,29 @@
+package csvutil
+
+import "regexp"
+
+// TrailingAnnotation matches bracketed annotation at the end of the value,
+// e.g. " [estimated]" in "42 [estimated]".
+var TrailingAnnotation = regexp.MustCompile(`\s*\[[^\]]*\]\s*$`)
+
+// StripAnnotation returns converter removing parts of the value matching re.
+func StripAnnotation(re *regexp.Regexp) Converter {
+	return func(value string) (string, error) {
+		return re.ReplaceAllString(value, ""), nil
+	}
+}
+
+// StripAnnotations removes parts of the values of columns matching re before
+// they are converted to the field type. Spreadsheets maintained by people
+// often have notes like "42 [estimated]" in numeric columns.
+//
+// Example:
+//
+//	c.StripAnnotations(csvutil.TrailingAnnotation, "Weight", "Price")
+func (r *Reader) StripAnnotations(re *regexp.Regexp, columns ...string) *Reader {
+	strip := StripAnnotation(re)
+	for _, col := range columns {
+		r.ColumnConverter(col, strip)
+	}
+	return r
+}
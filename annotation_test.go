@@ -0,0 +1,32 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"regexp"
+	"testing"
+)
+
+type measured struct {
+	Name   string
+	Weight float64
+	Count  int
+}
+
+func Test_StripAnnotations(t *testing.T) {
+	// Prepare test
+	c := FromString("Box [big],42.5 [estimated],3 (approx)\n").
+		StripAnnotations(TrailingAnnotation, "Weight").
+		StripAnnotations(regexp.MustCompile(`\s*\(.*\)$`), "Count")
+
+	// Start test
+	got := &measured{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, measured{"Box [big]", 42.5, 3}, *got)
+}
+
+func Test_AnnotationConverter(t *testing.T) {
+	// Start test
+	value, err := converters["annotation"]("7 [see note] ")
+	assert.NotError(t, err)
+	assert.Equal(t, "7", value)
+}
@@ -0,0 +1,129 @@
+package csvutil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// AssertSortedBy makes SetData check records come in ascending order of the
+// named structure field, equal values are allowed. Record with smaller value
+// than any before it is reported as *RowError, or as Warning when
+// AssertionWarnings is set. Numbers, strings, booleans and time.Time can
+// be compared.
+//
+// Example:
+//
+//	c := csvutil.NewCsvUtil(f).HeaderFromFirstRow().AssertSortedBy("Timestamp")
+func (r *Reader) AssertSortedBy(field string) *Reader {
+	r.sortField = field
+	r.sortPrev = reflect.Value{}
+	return r
+}
+
+// AssertSequential makes SetData check the named integer field numbers
+// records consecutively, e.g. to detect partner files which were cut or
+// sent twice. Gaps, repeated and decreasing numbers are reported as
+// *RowError, or as Warning when AssertionWarnings is set.
+//
+// Example:
+//
+//	c := csvutil.NewCsvUtil(f).AssertSequential("RowID")
+func (r *Reader) AssertSequential(field string) *Reader {
+	r.seqField = field
+	r.seqSeen = false
+	return r
+}
+
+// AssertionWarnings sets if failed assertions, AssertSortedBy and
+// AssertSequential, are reported through OnWarning instead of returned by
+// SetData (default: false).
+func (r *Reader) AssertionWarnings(b bool) *Reader {
+	r.assertWarn = b
+	return r
+}
+
+// assertRow checks assertions on the structure v populated from the current
+// record.
+func (r *Reader) assertRow(v reflect.Value) error {
+	if r.sortField != "" {
+		if err := r.assertSorted(v); err != nil {
+			return err
+		}
+	}
+	if r.seqField != "" {
+		if err := r.assertSequential(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assertSorted checks the sort field of v is not smaller than in previous
+// records.
+func (r *Reader) assertSorted(v reflect.Value) error {
+	f := v.FieldByName(r.sortField)
+	if !f.IsValid() {
+		return fmt.Errorf("no field %s in %s", r.sortField, v.Type())
+	}
+
+	if compare(f, f) == -2 {
+		return fmt.Errorf("can't sort by field %s of type %s", r.sortField, f.Type())
+	}
+	if r.sortPrev.IsValid() && compare(f, r.sortPrev) < 0 {
+		return r.assertFailed(r.sortField, fmt.Sprint(f.Interface()), fmt.Sprintf("not sorted by %s: %v after %v", r.sortField, f.Interface(), r.sortPrev.Interface()))
+	}
+
+	r.sortPrev = reflect.New(f.Type()).Elem()
+	r.sortPrev.Set(f)
+	return nil
+}
+
+// assertSequential checks the sequence field of v follows the one of the
+// previous record.
+func (r *Reader) assertSequential(v reflect.Value) error {
+	f := v.FieldByName(r.seqField)
+	if !f.IsValid() {
+		return fmt.Errorf("no field %s in %s", r.seqField, v.Type())
+	}
+
+	var n int64
+	switch f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = f.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n = int64(f.Uint())
+	default:
+		return fmt.Errorf("can't check sequence of field %s of type %s", r.seqField, f.Type())
+	}
+
+	prev, seen := r.seqPrev, r.seqSeen
+	if !seen || n > prev {
+		r.seqPrev, r.seqSeen = n, true
+	}
+	if !seen || n == prev+1 {
+		return nil
+	}
+
+	value := strconv.FormatInt(n, 10)
+	switch {
+	case n == prev:
+		return r.assertFailed(r.seqField, value, fmt.Sprintf("duplicate %s %d", r.seqField, n))
+	case n < prev:
+		return r.assertFailed(r.seqField, value, fmt.Sprintf("%s %d after %d", r.seqField, n, prev))
+	case n == prev+2:
+		return r.assertFailed(r.seqField, value, fmt.Sprintf("%s %d missing", r.seqField, prev+1))
+	}
+	return r.assertFailed(r.seqField, value, fmt.Sprintf("%s %d to %d missing", r.seqField, prev+1, n-1))
+}
+
+// assertFailed returns error for the failed assertion or reports it as
+// warning when AssertionWarnings is set.
+func (r *Reader) assertFailed(field, value, msg string) error {
+	if r.assertWarn {
+		r.warn(field, value, msg)
+		return nil
+	}
+	return &RowError{Row: r.row, Err: errors.New(msg)}
+}
@@ -0,0 +1,80 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+	"time"
+)
+
+type event struct {
+	ID        int
+	Timestamp time.Time
+}
+
+type sequenced struct {
+	RowID int
+	Name  string
+}
+
+func Test_AssertSortedBy(t *testing.T) {
+	// Prepare test
+	c := FromString("1,2020-01-01T10:00:00Z\n2,2020-01-01T10:00:00Z\n3,2020-01-01T09:00:00Z\n4,2020-01-01T11:00:00Z\n").AssertSortedBy("Timestamp")
+
+	// Start test
+	got := &event{}
+	assert.NotError(t, c.SetData(got))
+	assert.NotError(t, c.SetData(got))
+	err := c.SetData(got)
+	assert.Equal(t, "row 3: not sorted by Timestamp: 2020-01-01 09:00:00 +0000 UTC after 2020-01-01 10:00:00 +0000 UTC", err.Error())
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, 4, got.ID)
+}
+
+func Test_AssertSortedByWarnings(t *testing.T) {
+	// Prepare test
+	var warnings []Warning
+	c := FromString("Tony,3\nJohn,1\nAnn,2\n").
+		AssertSortedBy("Age").
+		AssertionWarnings(true).
+		OnWarning(func(w Warning) { warnings = append(warnings, w) })
+
+	// Start test
+	for i := 0; i < 3; i++ {
+		assert.NotError(t, c.SetData(&warned{}))
+	}
+	assert.Equal(t, []Warning{
+		{Line: 2, Column: "Age", Value: "1", Message: "not sorted by Age: 1 after 3"},
+		{Line: 3, Column: "Age", Value: "2", Message: "not sorted by Age: 2 after 3"},
+	}, warnings)
+
+	err := FromString("Tony,3\n").AssertSortedBy("Email").SetData(&warned{})
+	assert.Equal(t, "no field Email in csvutil.warned", err.Error())
+}
+
+func Test_AssertSequential(t *testing.T) {
+	// Prepare test
+	var warnings []Warning
+	c := FromString("1,a\n2,b\n2,c\n5,d\n4,e\n7,f\n8,g\n").
+		AssertSequential("RowID").
+		AssertionWarnings(true).
+		OnWarning(func(w Warning) { warnings = append(warnings, w) })
+
+	// Start test
+	for i := 0; i < 7; i++ {
+		assert.NotError(t, c.SetData(&sequenced{}))
+	}
+	assert.Equal(t, []string{"duplicate RowID 2", "RowID 3 to 4 missing", "RowID 4 after 5", "RowID 6 missing"}, warningMessages(warnings))
+
+	c = FromString("1,a\n3,b\n").AssertSequential("RowID")
+	assert.NotError(t, c.SetData(&sequenced{}))
+	assert.Equal(t, "row 2: RowID 2 missing", c.SetData(&sequenced{}).Error())
+}
+
+// warningMessages returns messages of the warnings.
+func warningMessages(warnings []Warning) []string {
+	msgs := make([]string, len(warnings))
+	for i, w := range warnings {
+		msgs[i] = w.Message
+	}
+	return msgs
+}
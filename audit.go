@@ -0,0 +1,96 @@
+package csvutil
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ChangeKind names transformation applied to CSV value while decoding.
+type ChangeKind string
+
+// Transformations recorded by Reader.Audit.
+const (
+	ChangeTrim    ChangeKind = "trim"    // Whitespace or Trim characters removed
+	ChangeNull    ChangeKind = "null"    // Null sentinel replaced with empty value
+	ChangeConvert ChangeKind = "convert" // Column converter applied
+	ChangeID      ChangeKind = "id"      // Excel text formula unwrapped
+	ChangeCents   ChangeKind = "cents"   // Money amount converted to minor units
+	ChangeBool    ChangeKind = "bool"    // Custom boolean value translated
+	ChangeCoerce  ChangeKind = "coerce"  // Value coerced to the field type
+	ChangeDefault ChangeKind = "default" // Empty value replaced with zero value
+)
+
+// Change describes single transformation of CSV value.
+type Change struct {
+	Column string     // Column name
+	Kind   ChangeKind // What has been done
+	From   string     // Value before the transformation
+	To     string     // Value after the transformation
+}
+
+// Audit makes Reader record every transformation of CSV values made while
+// decoding the record. Values of encrypted columns are recorded before
+// decryption only.
+func (r *Reader) Audit(b bool) *Reader {
+	r.audit = b
+	return r
+}
+
+// Changes returns transformations applied to values of the most recent
+// record in the order they were made. Returns nil unless Audit is enabled.
+//
+// Example:
+//
+//	c := NewCsvUtil(f).Null("NULL").Audit(true)
+//	for c.SetData(p) == nil {
+//		for _, ch := range c.Changes() {
+//			log.Printf("%s: %s %q -> %q", ch.Column, ch.Kind, ch.From, ch.To)
+//		}
+//	}
+func (r *Reader) Changes() []Change {
+	return r.changes
+}
+
+// note records transformation of column value if it changed the value.
+func (r *Reader) note(col string, kind ChangeKind, from, to string) {
+	if r.audit && from != to {
+		r.changes = append(r.changes, Change{Column: col, Kind: kind, From: from, To: to})
+	}
+}
+
+// noteSet records transformations made while setting the value on elem.
+func (r *Reader) noteSet(f *sField, elem reflect.Value, value string) {
+	if elem.Kind() == reflect.Ptr {
+		if elem.IsNil() {
+			return
+		}
+		elem = elem.Elem()
+	}
+	to := fmt.Sprint(elem.Interface())
+
+	var err error
+	switch elem.Kind() {
+	case reflect.Bool:
+		if tr := r.boolTr(value); tr != value {
+			r.note(f.col, ChangeBool, value, to)
+			return
+		}
+		_, err = strconv.ParseBool(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		_, err = strconv.ParseUint(value, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		_, err = strconv.ParseFloat(value, 64)
+	default:
+		return
+	}
+
+	if value == "" {
+		r.note(f.col, ChangeDefault, value, to)
+	} else if err != nil {
+		r.note(f.col, ChangeCoerce, value, to)
+		r.warn(f.col, value, "coerced to "+to)
+	}
+}
@@ -0,0 +1,39 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type audited struct {
+	Name   string
+	Age    int
+	Active bool
+	Score  float64
+	Zip    string `csv:"Zip,id=excel"`
+}
+
+func Test_Audit(t *testing.T) {
+	// Prepare test
+	c := FromString(" Tony ,1.0,Y,NULL,=\"01234\"\nJohn,2,true,1.5,1\n").
+		Whitespace(WhitespaceTrimAll).
+		Null("NULL").
+		CustomBool([]string{"Y"}, []string{"N"}).LazyQuotes(true).
+		Coercions(Coercions{FloatToInt: true, EmptyToZero: true}).
+		Audit(true)
+
+	// Start test
+	got := &audited{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, []Change{
+		{Column: "Name", Kind: ChangeTrim, From: "Tony ", To: "Tony"},
+		{Column: "Age", Kind: ChangeCoerce, From: "1.0", To: "1"},
+		{Column: "Active", Kind: ChangeBool, From: "Y", To: "true"},
+		{Column: "Score", Kind: ChangeNull, From: "NULL", To: ""},
+		{Column: "Score", Kind: ChangeDefault, From: "", To: "0"},
+		{Column: "Zip", Kind: ChangeID, From: "=\"01234\"", To: "01234"},
+	}, c.Changes())
+
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, []Change(nil), c.Changes())
+}
@@ -0,0 +1,172 @@
+package csvutil
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// Marshaler encodes decoded row to message.
+type Marshaler func(v interface{}) ([]byte, error)
+
+// Batch is a group of marshaled rows ready to be published.
+type Batch struct {
+	Messages [][]byte    // Marshaled rows
+	Size     int         // Total size of messages in bytes
+	Errors   []*RowError // Rows which failed to decode or marshal
+}
+
+// Batcher decodes CSV rows, marshals them and groups them into batches
+// bounded by number of messages and their total size.
+type Batcher struct {
+	r        *Reader
+	newRow   func() interface{}
+	marshal  Marshaler
+	maxRows  int
+	maxBytes int
+	row      int    // Number of rows read so far
+	pending  []byte // Message which did not fit into previous batch
+	done     bool   // True if input has been fully read
+}
+
+// NewBatcher returns Batcher reading rows from r. The newRow returns pointer
+// to new struct for each row. Batches hold at most maxRows messages of total
+// size at most maxBytes, zero means no limit. Message bigger than maxBytes
+// is put alone into a batch. Rows are marshaled to JSON by default.
+func NewBatcher(r *Reader, newRow func() interface{}, maxRows, maxBytes int) *Batcher {
+	return &Batcher{r: r, newRow: newRow, marshal: json.Marshal, maxRows: maxRows, maxBytes: maxBytes}
+}
+
+// Marshal sets function used to marshal rows.
+func (b *Batcher) Marshal(m Marshaler) *Batcher {
+	b.marshal = m
+	return b
+}
+
+// Next returns next batch. Returns io.EOF when no more rows exist.
+// Errors of rows which failed to decode or marshal are reported in the batch
+// they would belong to, other errors are returned.
+func (b *Batcher) Next() (*Batch, error) {
+	batch := &Batch{}
+
+	if b.pending != nil {
+		batch.add(b.pending)
+		b.pending = nil
+	}
+
+	for !b.done && !b.full(batch) {
+		v := b.newRow()
+		err := b.r.SetData(v)
+		if err == io.EOF {
+			b.done = true
+			break
+		}
+		b.row++
+		if err != nil {
+			if _, ok := err.(*RowError); !ok {
+				err = &RowError{Row: b.row, Err: err}
+			}
+			batch.Errors = append(batch.Errors, err.(*RowError))
+			continue
+		}
+
+		msg, err := b.marshal(v)
+		if err != nil {
+			batch.Errors = append(batch.Errors, &RowError{Row: b.row, Err: err})
+			continue
+		}
+
+		if b.maxBytes > 0 && len(batch.Messages) > 0 && batch.Size+len(msg) > b.maxBytes {
+			b.pending = msg
+			break
+		}
+		batch.add(msg)
+	}
+
+	if len(batch.Messages) == 0 && len(batch.Errors) == 0 {
+		return nil, io.EOF
+	}
+	return batch, nil
+}
+
+// full returns true if no more messages can be added to the batch.
+func (b *Batcher) full(batch *Batch) bool {
+	if b.maxRows > 0 && len(batch.Messages) >= b.maxRows {
+		return true
+	}
+	return b.maxBytes > 0 && batch.Size >= b.maxBytes
+}
+
+// add adds message to the batch.
+func (batch *Batch) add(msg []byte) {
+	batch.Messages = append(batch.Messages, msg)
+	batch.Size += len(msg)
+}
+
+// BatchRetries sets how many times Batches passes the same batch again
+// to the callback which returned error (default: 0).
+func (r *Reader) BatchRetries(n int) *Reader {
+	r.retries = n
+	return r
+}
+
+// Batches decodes records into structures returned by newRow and passes
+// them to fn in batches of at most n rows, e.g. to commit each batch in one
+// database transaction. Batches also end at records matched by Sentinel.
+// Failed batch is retried as set with BatchRetries, after that the error
+// is returned and the next call to Batches starts with the same batch so
+// no rows are lost. Decoding errors are returned immediately with the rows
+// decoded so far kept for the next call. Returns nil when all records have
+// been processed and an error if n is less than one.
+//
+// Example:
+//
+//	err := c.BatchRetries(3).Batches(100, func() interface{} { return &person{} },
+//		func(batch []interface{}) error {
+//			return db.Insert(batch)
+//		})
+func (r *Reader) Batches(n int, newRow func() interface{}, fn func(batch []interface{}) error) error {
+	if n < 1 {
+		return errors.New("batch size must be positive")
+	}
+	batch := r.pending
+	r.pending = nil
+
+	for {
+		done := false
+		for len(batch) < n {
+			v := newRow()
+			err := r.SetData(v)
+			if err == io.EOF {
+				done = true
+				break
+			}
+			if err == ErrBatchBoundary {
+				break
+			}
+			if err != nil {
+				r.pending = batch
+				return err
+			}
+			batch = append(batch, v)
+		}
+
+		if len(batch) > 0 {
+			var err error
+			for try := 0; try <= r.retries; try++ {
+				if err = fn(batch); err == nil {
+					break
+				}
+			}
+			if err != nil {
+				r.pending = batch
+				return err
+			}
+		}
+
+		if done {
+			return nil
+		}
+		batch = nil
+	}
+}
@@ -0,0 +1,111 @@
+package csvutil
+
+import (
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+func Test_Batcher(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("Tony,1\nJohn,x\nAnna,3\nMark,4\nEve,5")
+	c := NewCsvUtil(sr)
+
+	type batchRow struct {
+		Name string
+		Age  int
+	}
+
+	// Start test
+	b := NewBatcher(c, func() interface{} { return &batchRow{} }, 2, 0)
+
+	batch, err := b.Next()
+	assert.NotError(t, err)
+	assert.Equal(t, [][]byte{[]byte(`{"Name":"Tony","Age":1}`), []byte(`{"Name":"Anna","Age":3}`)}, batch.Messages)
+	assert.Equal(t, 1, len(batch.Errors))
+	assert.Equal(t, 2, batch.Errors[0].Row)
+
+	batch, err = b.Next()
+	assert.NotError(t, err)
+	assert.Equal(t, 2, len(batch.Messages))
+	assert.Equal(t, 0, len(batch.Errors))
+	assert.Equal(t, 45, batch.Size)
+
+	_, err = b.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func Test_BatcherMaxBytes(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("a\nbb\nccc\ndddddd")
+	c := NewCsvUtil(sr)
+
+	type bytesRow struct {
+		V string
+	}
+	b := NewBatcher(c, func() interface{} { return &bytesRow{} }, 0, 5).
+		Marshal(func(v interface{}) ([]byte, error) { return []byte(v.(*bytesRow).V), nil })
+
+	// Start test
+	var got []string
+	for {
+		batch, err := b.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NotError(t, err)
+		var msgs []string
+		for _, m := range batch.Messages {
+			msgs = append(msgs, string(m))
+		}
+		got = append(got, strings.Join(msgs, "+"))
+	}
+	assert.Equal(t, []string{"a+bb", "ccc", "dddddd"}, got)
+}
+
+func Test_Batches(t *testing.T) {
+	// Prepare test
+	type txRow struct {
+		Name string
+	}
+	c := FromString("a\nb\nc\nd\ne").BatchRetries(1)
+	newRow := func() interface{} { return &txRow{} }
+
+	var committed []string
+	calls := 0
+	commit := func(batch []interface{}) error {
+		calls++
+		if calls == 2 || calls == 3 {
+			return errors.New("deadlock")
+		}
+		for _, v := range batch {
+			committed = append(committed, v.(*txRow).Name)
+		}
+		return nil
+	}
+
+	// Start test
+	err := c.Batches(2, newRow, commit)
+	assert.Equal(t, "deadlock", err.Error())
+	assert.Equal(t, []string{"a", "b"}, committed)
+
+	assert.NotError(t, c.Batches(2, newRow, commit))
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, committed)
+	assert.Equal(t, 5, calls)
+}
+
+func Test_BatchesSize(t *testing.T) {
+	// Prepare test
+	c := FromString("a\nb")
+	called := false
+
+	// Start test
+	err := c.Batches(0, func() interface{} { return &person{} }, func(batch []interface{}) error {
+		called = true
+		return nil
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, false, called)
+}
@@ -0,0 +1,60 @@
+package csvutil
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// BlobOpener opens named objects from a blob store such as S3 or GCS.
+// Adapters for particular stores are provided by the user which keeps
+// cloud SDKs out of this package.
+type BlobOpener interface {
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// BlobOpenerFunc adapts ordinary function to BlobOpener interface.
+type BlobOpenerFunc func(ctx context.Context, name string) (io.ReadCloser, error)
+
+// Open calls f(ctx, name).
+func (f BlobOpenerFunc) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	return f(ctx, name)
+}
+
+// DirOpener opens files relative to the directory.
+type DirOpener string
+
+// Open opens the named file.
+func (d DirOpener) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(string(d), filepath.FromSlash(name)))
+}
+
+// URLOpener opens objects relative to the base URL using OpenURL.
+type URLOpener struct {
+	Base    string      // Base URL, object names are appended to it
+	Options *URLOptions // Options passed to OpenURL
+}
+
+// Open opens the named object.
+func (u *URLOpener) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	return OpenURL(ctx, strings.TrimSuffix(u.Base, "/")+"/"+strings.TrimPrefix(name, "/"), u.Options)
+}
+
+// OpenBlob opens the named object and returns Reader for it.
+// Compressed objects are decompressed. Closing the Reader closes the object.
+func OpenBlob(ctx context.Context, o BlobOpener, name string) (*Reader, error) {
+	rc, err := o.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	drc, err := Decompress(rc)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+
+	return NewCsvUtil(drc), nil
+}
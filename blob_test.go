@@ -0,0 +1,62 @@
+package csvutil
+
+import (
+	"context"
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_OpenBlobDir(t *testing.T) {
+	// Prepare test
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "people.csv"), []byte(strings.Join(testCsvLines, "\n")), 0644)
+	assert.NotError(t, err)
+
+	// Start test
+	c, err := OpenBlob(context.Background(), DirOpener(dir), "people.csv")
+	assert.NotError(t, err)
+	c.Comma('|').TrailingComma(true).FieldsPerRecord(-1).CustomBool([]string{"Y"}, []string{"N"})
+
+	p := &person{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "Tony", p.Name)
+	assert.NotError(t, c.Close())
+}
+
+func Test_OpenBlobURL(t *testing.T) {
+	// Prepare test
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.URL.Path)
+	}))
+	defer srv.Close()
+
+	// Start test
+	c, err := OpenBlob(context.Background(), &URLOpener{Base: srv.URL + "/bucket/"}, "/a/b.csv")
+	assert.NotError(t, err)
+	defer c.Close()
+
+	l, err := c.read()
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"/bucket/a/b.csv"}, l)
+}
+
+func Test_BlobOpenerFunc(t *testing.T) {
+	// Prepare test
+	o := BlobOpenerFunc(func(ctx context.Context, name string) (io.ReadCloser, error) {
+		return NewStringReadCloser(name), nil
+	})
+
+	// Start test
+	c, err := OpenBlob(context.Background(), o, "x,y")
+	assert.NotError(t, err)
+
+	l, err := c.read()
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"x", "y"}, l)
+}
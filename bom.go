@@ -0,0 +1,59 @@
+package csvutil
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// utf8BOM is UTF-8 encoded byte order mark.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// StripBOMs removes UTF-8 byte order marks from the start of the input and
+// of every line. Files concatenated from several exports often carry the
+// BOM at the start of every chunk where it would end up in the first value
+// of the record. Input offsets reported by RowMeta and Checkpoint don't
+// count removed bytes. Must be called before the first record is read.
+func (r *Reader) StripBOMs() *Reader {
+	r.wrap(func(src io.Reader) io.Reader {
+		return &bomReader{br: bufio.NewReader(src), atLine: true}
+	})
+	return r
+}
+
+// bomReader reads from br skipping byte order marks at line starts.
+type bomReader struct {
+	br     *bufio.Reader
+	atLine bool // True if the next byte starts a line
+}
+
+func (b *bomReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if b.atLine {
+		if peek, _ := b.br.Peek(len(utf8BOM)); bytes.Equal(peek, utf8BOM) {
+			b.br.Discard(len(utf8BOM))
+		}
+		b.atLine = false
+	}
+
+	if b.br.Buffered() == 0 {
+		if _, err := b.br.Peek(1); err != nil {
+			return 0, err
+		}
+	}
+
+	// Never read past the end of line so the next line can be checked.
+	buf, _ := b.br.Peek(b.br.Buffered())
+	if len(buf) > len(p) {
+		buf = buf[:len(p)]
+	}
+	if i := bytes.IndexByte(buf, '\n'); i >= 0 {
+		buf = buf[:i+1]
+		b.atLine = true
+	}
+	n := copy(p, buf)
+	b.br.Discard(n)
+	return n, nil
+}
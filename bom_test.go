@@ -0,0 +1,36 @@
+package csvutil
+
+import (
+	"bufio"
+	"github.com/rzajac/goassert/assert"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func Test_StripBOMs(t *testing.T) {
+	// Prepare test
+	bom := "\xEF\xBB\xBF"
+	sr := NewStringReadCloser(bom + "Tony,23,1.5,true\n" + bom + "John,34,2,false\r\n" + bom + "\"A" + bom + "nn\",41,3,true")
+	c := NewCsvUtil(sr).StripBOMs()
+
+	// Start test
+	p := &person{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "Tony", p.Name)
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "John", p.Name)
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "A"+bom+"nn", p.Name)
+}
+
+func Test_bomReaderOneByte(t *testing.T) {
+	// Prepare test
+	b := &bomReader{br: bufio.NewReader(strings.NewReader("\xEF\xBB\xBFa\n\xEF\xBB\xBFb\n\xEF\xBB")), atLine: true}
+
+	// Start test
+	data, err := ioutil.ReadAll(iotest.OneByteReader(b))
+	assert.NotError(t, err)
+	assert.Equal(t, "a\nb\n\xEF\xBB", string(data))
+}
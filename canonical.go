@@ -0,0 +1,108 @@
+package csvutil
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Canonicalize writes CSV file read from r to w in the canonical form
+// suitable for text diffing and content addressed storage. The dialect of
+// the input is detected, the first record is the header. In the output:
+//
+//   - values are trimmed and quoted only when necessary,
+//   - fields are delimited with comma and records end with \n,
+//   - columns are ordered by header names,
+//   - rows are ordered by values of keyCols, then by all values.
+//
+// Example:
+//
+//	err := csvutil.Canonicalize(in, out, "OrderID")
+func Canonicalize(r io.Reader, w io.Writer, keyCols ...string) error {
+	header, rows, err := readTable(r)
+	if err != nil || header == nil {
+		return err
+	}
+	for _, record := range append([][]string{header}, rows...) {
+		for i, value := range record {
+			record[i] = strings.TrimSpace(value)
+		}
+	}
+
+	// Column positions in the canonical order.
+	order := make([]int, len(header))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return header[order[i]] < header[order[j]] })
+
+	keys, err := columnIndexes(header, keyCols)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, k := range keys {
+			if rows[i][k] != rows[j][k] {
+				return rows[i][k] < rows[j][k]
+			}
+		}
+		for _, k := range order {
+			if rows[i][k] != rows[j][k] {
+				return rows[i][k] < rows[j][k]
+			}
+		}
+		return false
+	})
+
+	cw := Dialect{}.NewWriter(w)
+	out := make([]string, len(header))
+	for _, record := range append([][]string{header}, rows...) {
+		for i, k := range order {
+			out[i] = record[k]
+		}
+		if err = cw.Write(out); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// readTable reads header and records of the CSV file in detected dialect. All records must have the same number of fields as the header.
+func readTable(r io.Reader) ([]string, [][]string, error) {
+	d, br, err := sniffDialect(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := NewCsvUtil(br).OwnsSource(false).Dialect(d)
+	var table [][]string
+	for {
+		record, err := c.read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		table = append(table, record)
+	}
+
+	if len(table) == 0 {
+		return nil, nil, nil
+	}
+	return table[0], table[1:], nil
+}
+
+// columnIndexes returns positions of columns in the header.
+func columnIndexes(header, columns []string) ([]int, error) {
+	idx := make([]int, len(columns))
+	for i, col := range columns {
+		if idx[i] = indexOf(header, col); idx[i] < 0 {
+			return nil, fmt.Errorf("column %s is not in the header", col)
+		}
+	}
+	return idx, nil
+}
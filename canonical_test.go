@@ -0,0 +1,35 @@
+package csvutil
+
+import (
+	"bytes"
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+func Test_Canonicalize(t *testing.T) {
+	// Prepare test
+	in := "Name; ID ;Note\r\n\"John\";2; late \r\nTony;1;\"a;b\"\r\nAnn;2;\"\"\r\n"
+	var buf bytes.Buffer
+
+	// Start test
+	assert.NotError(t, Canonicalize(strings.NewReader(in), &buf, "ID"))
+	assert.Equal(t, "ID,Name,Note\n1,Tony,a;b\n2,Ann,\n2,John,late\n", buf.String())
+
+	buf.Reset()
+	assert.NotError(t, Canonicalize(strings.NewReader(in), &buf))
+	assert.Equal(t, "ID,Name,Note\n1,Tony,a;b\n2,Ann,\n2,John,late\n", buf.String())
+
+	assert.NotNil(t, Canonicalize(strings.NewReader(in), &buf, "Email"))
+	assert.NotNil(t, Canonicalize(strings.NewReader("a,b\n1,2,3\n"), &buf))
+}
+
+func Test_CanonicalizeSameContent(t *testing.T) {
+	// Prepare test
+	var a, b bytes.Buffer
+
+	// Start test
+	assert.NotError(t, Canonicalize(strings.NewReader("x,y\n1,\"2\"\n3,4\n"), &a, "x"))
+	assert.NotError(t, Canonicalize(strings.NewReader("y;x\n4;3\n 2 ;1\n"), &b, "x"))
+	assert.Equal(t, a.String(), b.String())
+}
@@ -0,0 +1,176 @@
+package csvutil
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Kinds of cell changes reported by ChangedCells.
+const (
+	CellModified = iota // Value of the row present in both files changed
+	CellAdded           // The row is present only in the new file
+	CellRemoved         // The row is present only in the old file
+)
+
+// CellChange describes value which differs between two versions of a file.
+type CellChange struct {
+	Kind   int      // One of Cell* constants
+	Key    []string // Values of the key columns
+	Column string   // Column name
+	Old    string   // Value in the old file
+	New    string   // Value in the new file
+}
+
+// ChangedCells compares the old version a of CSV file with the new version
+// b and calls fn for every changed value. Rows are matched by values of keyCols, all columns
+// are used when no keyCols are given. For added and removed rows fn is
+// called for every column. Columns are matched by header names, column
+// missing in one of the files has empty values. The old file is read to
+// memory while the new one is streamed, calling fn in its row order.
+// Removed rows are reported last. Returns the first error returned by fn.
+//
+// Example:
+//
+//	err := csvutil.ChangedCells(yesterday, today, func(c csvutil.CellChange) error {
+//		return store.Update(c.Key[0], c.Column, c.New)
+//	}, "ID")
+func ChangedCells(a, b io.Reader, fn func(c CellChange) error, keyCols ...string) error {
+	oldHeader, oldRows, err := readTable(a)
+	if err != nil {
+		return err
+	}
+
+	d, br, err := sniffDialect(b)
+	if err != nil {
+		return err
+	}
+	c := NewCsvUtil(br).OwnsSource(false).Dialect(d)
+	newHeader, err := c.read()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	newHeader = append([]string(nil), newHeader...)
+
+	columns, oldPos, newPos := unionColumns(oldHeader, newHeader)
+	// Without key columns rows are matched by all values.
+	oldKeys, newKeys := oldPos, newPos
+	if len(keyCols) > 0 {
+		if oldKeys, err = columnIndexes(oldHeader, keyCols); err == nil {
+			newKeys, err = columnIndexes(newHeader, keyCols)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	byKey := make(map[string][]string, len(oldRows))
+	order := make([]string, 0, len(oldRows))
+	for _, record := range oldRows {
+		k := tableKey(record, oldKeys)
+		if _, ok := byKey[k]; ok {
+			return fmt.Errorf("duplicate key %v in old file", keyValues(record, oldKeys))
+		}
+		byKey[k] = record
+		order = append(order, k)
+	}
+
+	seen := make(map[string]bool, len(oldRows))
+	for len(newHeader) > 0 {
+		record, err := c.read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		k := tableKey(record, newKeys)
+		if seen[k] {
+			return fmt.Errorf("duplicate key %v in new file", keyValues(record, newKeys))
+		}
+		seen[k] = true
+
+		prev, ok := byKey[k]
+		kind := CellModified
+		if !ok {
+			kind = CellAdded
+		}
+		for i, col := range columns {
+			o, n := cell(prev, oldPos[i]), cell(record, newPos[i])
+			if ok && o == n {
+				continue
+			}
+			if err = fn(CellChange{Kind: kind, Key: keyValues(record, newKeys), Column: col, Old: o, New: n}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, k := range order {
+		if seen[k] {
+			continue
+		}
+		record := byKey[k]
+		for i, col := range columns {
+			if err = fn(CellChange{Kind: CellRemoved, Key: keyValues(record, oldKeys), Column: col, Old: cell(record, oldPos[i])}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// unionColumns returns names of columns from both headers and their
+// positions in each of them, -1 where the column is missing.
+func unionColumns(a, b []string) ([]string, []int, []int) {
+	var columns []string
+	var posA, posB []int
+	inA := make(map[string]bool, len(a))
+	for i, name := range a {
+		inA[name] = true
+		columns = append(columns, name)
+		posA = append(posA, i)
+		posB = append(posB, indexOf(b, name))
+	}
+	for i, name := range b {
+		if !inA[name] {
+			columns = append(columns, name)
+			posA = append(posA, -1)
+			posB = append(posB, i)
+		}
+	}
+	return columns, posA, posB
+}
+
+// indexOf returns position of s in list or -1.
+func indexOf(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// cell returns value at position i of the record or empty string.
+func cell(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+	return record[i]
+}
+
+// keyValues returns values of the key columns.
+func keyValues(record []string, keys []int) []string {
+	values := make([]string, len(keys))
+	for i, k := range keys {
+		values[i] = cell(record, k)
+	}
+	return values
+}
+
+// tableKey returns map key of the record.
+func tableKey(record []string, keys []int) string {
+	return strings.Join(keyValues(record, keys), "\x00")
+}
@@ -0,0 +1,50 @@
+package csvutil
+
+import (
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+func Test_ChangedCells(t *testing.T) {
+	// Prepare test
+	a := "ID,Name,Age\n1,Tony,23\n2,John,34\n3,Ann,41\n"
+	b := "Age;ID;Name;Email\n24;1;Tony;t@x\n34;2;John;\n50;4;Jo;\n"
+
+	// Start test
+	var got []CellChange
+	err := ChangedCells(strings.NewReader(a), strings.NewReader(b), func(c CellChange) error {
+		got = append(got, c)
+		return nil
+	}, "ID")
+	assert.NotError(t, err)
+	assert.Equal(t, []CellChange{
+		{Kind: CellModified, Key: []string{"1"}, Column: "Age", Old: "23", New: "24"},
+		{Kind: CellModified, Key: []string{"1"}, Column: "Email", New: "t@x"},
+		{Kind: CellAdded, Key: []string{"4"}, Column: "ID", New: "4"},
+		{Kind: CellAdded, Key: []string{"4"}, Column: "Name", New: "Jo"},
+		{Kind: CellAdded, Key: []string{"4"}, Column: "Age", New: "50"},
+		{Kind: CellAdded, Key: []string{"4"}, Column: "Email"},
+		{Kind: CellRemoved, Key: []string{"3"}, Column: "ID", Old: "3"},
+		{Kind: CellRemoved, Key: []string{"3"}, Column: "Name", Old: "Ann"},
+		{Kind: CellRemoved, Key: []string{"3"}, Column: "Age", Old: "41"},
+		{Kind: CellRemoved, Key: []string{"3"}, Column: "Email"},
+	}, got)
+}
+
+func Test_ChangedCellsErrors(t *testing.T) {
+	// Prepare test
+	nop := func(c CellChange) error { return nil }
+
+	// Start test
+	err := ChangedCells(strings.NewReader("ID\n1\n1\n"), strings.NewReader("ID\n1\n"), nop, "ID")
+	assert.Equal(t, "duplicate key [1] in old file", err.Error())
+
+	err = ChangedCells(strings.NewReader("ID\n1\n"), strings.NewReader("Key\n1\n"), nop, "ID")
+	assert.NotNil(t, err)
+
+	stop := errors.New("stop")
+	err = ChangedCells(strings.NewReader("ID\n1\n"), strings.NewReader("ID\n2\n"), func(c CellChange) error { return stop })
+	assert.Equal(t, stop, err)
+}
@@ -0,0 +1,86 @@
+package csvutil
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+// checkpoint is serializable state of the Reader.
+type checkpoint struct {
+	Dialect Dialect   `json:"dialect"`
+	Columns []string  `json:"columns,omitempty"` // Header read from the file
+	Header  CsvHeader `json:"header,omitempty"`  // Header set with Header, HeaderFromFirstRow or Mapping
+	Offset  int64     `json:"offset"`            // Input offset of the next record
+	Lines   int       `json:"lines"`             // Number of lines before the next record
+	Row     int       `json:"row"`               // Number of records read
+	Source  string    `json:"source,omitempty"`
+}
+
+// Checkpoint returns serialized state of the Reader after the most recent
+// record. It can be passed to RestoreCheckpoint of a new Reader reading the
+// same input to continue with the next record, e.g. after a worker crash.
+//
+// Example:
+//
+//	for c.SetData(p) == nil {
+//		// Do work with p
+//		state, err := c.Checkpoint()
+//		// Store state
+//	}
+func (r *Reader) Checkpoint() ([]byte, error) {
+	cp := checkpoint{
+		Dialect: r.dialect(),
+		Columns: r.columns,
+		Offset:  r.base + r.csvr.InputOffset(),
+		Lines:   r.lines,
+		Row:     r.row,
+		Source:  r.source,
+	}
+	if r.customHeader {
+		cp.Header = r.header
+	}
+	if n := len(r.csvLine); n > 0 {
+		line, _ := r.fieldPos(n - 1)
+		cp.Lines = line + strings.Count(r.csvLine[n-1], "\n")
+	}
+	return json.Marshal(cp)
+}
+
+// RestoreCheckpoint restores Reader state saved with Checkpoint and moves
+// to the input offset of the next record. If the input is an io.Seeker it's
+// used, otherwise the bytes before the offset are read and dropped.
+// Must be called before the first record is read.
+func (r *Reader) RestoreCheckpoint(data []byte) error {
+	if r.row > 0 {
+		return errors.New("checkpoint must be restored before reading")
+	}
+
+	var cp checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return err
+	}
+
+	if s, ok := r.src.(io.Seeker); ok {
+		if _, err := s.Seek(cp.Offset, io.SeekStart); err != nil {
+			return err
+		}
+	} else if _, err := io.CopyN(io.Discard, r.src, cp.Offset); err != nil {
+		return err
+	}
+
+	r.Dialect(cp.Dialect)
+	r.columns = cp.Columns
+	if cp.Header != nil {
+		r.Header(cp.Header)
+	}
+	r.base = cp.Offset
+	r.lines = cp.Lines
+	r.row = cp.Row
+	r.skip = 0
+	if cp.Source != "" {
+		r.source = cp.Source
+	}
+	return nil
+}
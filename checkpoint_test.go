@@ -0,0 +1,48 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+type resumed struct {
+	RowMeta
+	Name string
+	Age  int
+}
+
+func Test_Checkpoint(t *testing.T) {
+	// Prepare test
+	data := "# people\nAge;Name\n23;Tony\n34;\"John\nSmith\"\n45;Bob\n"
+	c := NewCsvUtil(strings.NewReader(data)).Comma(';').Comment('#').HeaderFromFirstRow()
+	p := &resumed{}
+	assert.NotError(t, c.SetData(p))
+	assert.NotError(t, c.SetData(p))
+	state, err := c.Checkpoint()
+	assert.NotError(t, err)
+
+	// Start test
+	for _, src := range []io.Reader{strings.NewReader(data), io.MultiReader(strings.NewReader(data))} {
+		c = NewCsvUtil(src)
+		assert.NotError(t, c.RestoreCheckpoint(state))
+		got := &resumed{}
+		assert.NotError(t, c.SetData(got))
+		assert.Equal(t, "Bob", got.Name)
+		assert.Equal(t, 45, got.Age)
+		assert.Equal(t, 6, got.Line)
+		assert.Equal(t, int64(len(data)-7), got.Offset)
+		assert.Equal(t, 4, c.row)
+		assert.Equal(t, io.EOF, c.SetData(got))
+	}
+}
+
+func Test_RestoreCheckpointAfterRead(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,23")
+	assert.NotError(t, c.SetData(&resumed{}))
+
+	// Start test
+	assert.NotNil(t, c.RestoreCheckpoint([]byte("{}")))
+}
@@ -0,0 +1,116 @@
+package csvutil
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"sync"
+)
+
+// Cipher encrypts and decrypts values of structure fields tagged with
+// encrypted option. The column is the CSV column name of the field.
+//
+// Example:
+//
+//	type person struct {
+//		Name string
+//		SSN  string `csv:"ssn,encrypted"`
+//	}
+type Cipher interface {
+	Encrypt(column, value string) (string, error)
+	Decrypt(column, value string) (string, error)
+}
+
+// ErrNoCipher is returned when encrypted field is used without registered Cipher.
+var ErrNoCipher = errors.New("no cipher registered for encrypted field")
+
+// Cipher used for encrypted fields.
+var (
+	cipherMu    sync.RWMutex
+	fieldCipher Cipher
+)
+
+// RegisterCipher sets Cipher used by ToCsv to encrypt and by SetData
+// to decrypt fields tagged with encrypted option.
+func RegisterCipher(c Cipher) {
+	cipherMu.Lock()
+	defer cipherMu.Unlock()
+	fieldCipher = c
+}
+
+// registeredCipher returns registered Cipher or nil.
+func registeredCipher() Cipher {
+	cipherMu.RLock()
+	defer cipherMu.RUnlock()
+	return fieldCipher
+}
+
+// encrypt encrypts value of the column with registered Cipher.
+func encrypt(column, value string) (string, error) {
+	c := registeredCipher()
+	if c == nil {
+		return "", ErrNoCipher
+	}
+	return c.Encrypt(column, value)
+}
+
+// decrypt decrypts value of the column mapped to the structure field.
+func (r *Reader) decrypt(f *sField, value string) (string, error) {
+	c := registeredCipher()
+	if c == nil {
+		return "", r.fieldError(f, value, ErrNoCipher)
+	}
+	plain, err := c.Decrypt(f.col, value)
+	if err != nil {
+		return "", r.fieldError(f, value, err)
+	}
+	return plain, nil
+}
+
+// aesCipher encrypts values with AES-GCM and encodes them with base64.
+type aesCipher struct {
+	aead cipher.AEAD
+}
+
+// NewAESCipher returns Cipher using AES-GCM with the key of 16, 24 or 32 bytes.
+// Encrypted values are base64 encoded, the column name is used as additional
+// authenticated data so values can't be moved between columns.
+func NewAESCipher(key []byte) (Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &aesCipher{aead: aead}, nil
+}
+
+func (c *aesCipher) Encrypt(column, value string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(value), []byte(column))
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *aesCipher) Decrypt(column, value string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", errors.New("encrypted value too short")
+	}
+	nonce := sealed[:c.aead.NonceSize()]
+	plain, err := c.aead.Open(nil, nonce, sealed[len(nonce):], []byte(column))
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
@@ -0,0 +1,89 @@
+package csvutil
+
+import (
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type secretPerson struct {
+	Name string
+	SSN  string `csv:"ssn,encrypted"`
+	Age  int    `csv:"age,encrypted"`
+}
+
+// upperCipher is reversible cipher for tests.
+type upperCipher struct{}
+
+func (upperCipher) Encrypt(column, value string) (string, error) {
+	return column + ":" + strings.ToUpper(value), nil
+}
+
+func (upperCipher) Decrypt(column, value string) (string, error) {
+	if !strings.HasPrefix(value, column+":") {
+		return "", errors.New("bad value")
+	}
+	return strings.ToLower(strings.TrimPrefix(value, column+":")), nil
+}
+
+func Test_parseTag(t *testing.T) {
+	// Prepare test
+	typ := reflect.TypeOf(secretPerson{})
+
+	// Start test
+	col, opts := parseTag(typ.Field(0))
+	assert.Equal(t, "Name", col)
+	assert.Equal(t, false, opts.has("encrypted"))
+
+	col, opts = parseTag(typ.Field(1))
+	assert.Equal(t, "ssn", col)
+	assert.Equal(t, true, opts.has("encrypted"))
+}
+
+func Test_EncryptedFields(t *testing.T) {
+	// Prepare test
+	RegisterCipher(upperCipher{})
+	defer RegisterCipher(nil)
+
+	// Start test
+	line := ToCsv(&secretPerson{Name: "Tony", SSN: "abc", Age: 23}, ",", "T", "F")
+	assert.Equal(t, "Tony,ssn:ABC,age:23", line)
+
+	c := NewCsvUtil(NewStringReadCloser(line + "\nJohn,bad,age:1"))
+	p := &secretPerson{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, secretPerson{Name: "Tony", SSN: "abc", Age: 23}, *p)
+
+	assert.Equal(t, &FieldError{Line: 2, Column: "ssn", Value: "bad", Err: errors.New("bad value")}, c.SetData(p))
+}
+
+func Test_EncryptedNoCipher(t *testing.T) {
+	// Start test
+	assert.Panic(t, func() { ToCsv(&secretPerson{SSN: "abc"}, ",", "T", "F") }, "Expected panic without cipher")
+
+	c := NewCsvUtil(NewStringReadCloser("Tony,x,1"))
+	err := c.SetData(&secretPerson{})
+	assert.Equal(t, ErrNoCipher, err.(*FieldError).Err)
+}
+
+func Test_AESCipher(t *testing.T) {
+	// Prepare test
+	c, err := NewAESCipher([]byte("0123456789abcdef"))
+	assert.NotError(t, err)
+
+	// Start test
+	enc, err := c.Encrypt("ssn", "123-45-6789")
+	assert.NotError(t, err)
+
+	dec, err := c.Decrypt("ssn", enc)
+	assert.NotError(t, err)
+	assert.Equal(t, "123-45-6789", dec)
+
+	_, err = c.Decrypt("name", enc)
+	assert.NotNil(t, err)
+
+	_, err = NewAESCipher([]byte("short"))
+	assert.NotNil(t, err)
+}
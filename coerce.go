@@ -0,0 +1,67 @@
+package csvutil
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+// Coercions lists conversions SetData may apply when CSV value does not
+// have the format of the structure field type.
+type Coercions struct {
+	FloatToInt  bool // "1.0" sets integer field as long as there is no fraction
+	BoolToInt   bool // Boolean values, including custom ones, set integer field to 1 or 0
+	IntToBool   bool // "1" and "0" set boolean field
+	EmptyToZero bool // Empty value sets numeric field to zero
+}
+
+// DefaultCoercions are coercions Reader applies unless configured otherwise.
+var DefaultCoercions = Coercions{IntToBool: true, EmptyToZero: true}
+
+// errEmpty is returned when empty value can't be coerced to zero.
+var errEmpty = errors.New("empty value")
+
+// Coercions sets conversions between types SetData is allowed to make
+// (default: DefaultCoercions). Use Coercions{} for the strictest decoding.
+func (r *Reader) Coercions(c Coercions) *Reader {
+	r.coerce = c
+	return r
+}
+
+// coerceInt converts value which failed integer parsing with err.
+func (r *Reader) coerceInt(value string, err error) (int64, error) {
+	rat, ok := r.coerceRat(value)
+	if !ok || !rat.Num().IsInt64() {
+		return 0, err
+	}
+	return rat.Num().Int64(), nil
+}
+
+// coerceUint converts value which failed unsigned integer parsing with err.
+func (r *Reader) coerceUint(value string, err error) (uint64, error) {
+	rat, ok := r.coerceRat(value)
+	if !ok || !rat.Num().IsUint64() {
+		return 0, err
+	}
+	return rat.Num().Uint64(), nil
+}
+
+// coerceRat returns integer represented by value according to coercions.
+func (r *Reader) coerceRat(value string) (*big.Rat, bool) {
+	if r.coerce.BoolToInt {
+		if b, err := strconv.ParseBool(r.boolTr(value)); err == nil {
+			if b {
+				return big.NewRat(1, 1), true
+			}
+			return new(big.Rat), true
+		}
+	}
+	if r.coerce.FloatToInt {
+		// ParseFloat rejects fractions like "4/2" big.Rat accepts.
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			rat, ok := new(big.Rat).SetString(value)
+			return rat, ok && rat.IsInt()
+		}
+	}
+	return nil, false
+}
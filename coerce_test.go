@@ -0,0 +1,46 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type coerced struct {
+	Count  int
+	Total  uint
+	Active bool
+	Ratio  float64
+}
+
+func Test_DefaultCoercions(t *testing.T) {
+	// Prepare test
+	c := FromString("1,2,1,\n1.0,2,true,1.5\n")
+
+	// Start test
+	got := &coerced{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, coerced{1, 2, true, 0}, *got)
+	assert.NotNil(t, c.SetData(got))
+}
+
+func Test_Coercions(t *testing.T) {
+	// Prepare test
+	data := "1.0,Y,T,1\n2.5,2,T,1\n"
+	cfg := Coercions{FloatToInt: true, BoolToInt: true}
+	c := FromString(data).CustomBool([]string{"Y"}, []string{"N"}).Coercions(cfg)
+
+	// Start test
+	got := &coerced{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, coerced{1, 1, true, 1}, *got)
+	assert.NotNil(t, c.SetData(got))
+}
+
+func Test_StrictCoercions(t *testing.T) {
+	// Start test
+	for _, line := range []string{"1,2,1,1.5", "1,2,t,", "1.0,2,t,1", "true,2,t,1"} {
+		got := &coerced{}
+		assert.NotNil(t, FromString(line).Coercions(Coercions{}).SetData(got))
+	}
+	assert.NotError(t, FromString("1,2,t,1.5").Coercions(Coercions{}).SetData(&coerced{}))
+}
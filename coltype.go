@@ -0,0 +1,77 @@
+package csvutil
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// ColumnType forces values of the column set on interface{} fields to be
+// decoded as typ, one of TypeString, TypeInt, TypeFloat or TypeBool,
+// instead of the inferred type. Use it for columns which look numeric but
+// are not numbers, e.g. account IDs with leading zeros.
+//
+// Values set on interface{} fields are int64, float64, bool or string,
+// whichever parses first, and nil for empty values.
+//
+// Example:
+//
+//	type row struct {
+//		AccountID interface{} `csv:"account_id"`
+//	}
+//
+//	c.ColumnType("account_id", csvutil.TypeString)
+func (r *Reader) ColumnType(column, typ string) *Reader {
+	switch typ {
+	case TypeString, TypeInt, TypeFloat, TypeBool:
+	default:
+		panic("Column " + column + ": unknown type " + typ)
+	}
+	if r.colTypes == nil {
+		r.colTypes = make(map[string]string)
+	}
+	r.colTypes[column] = typ
+	return r
+}
+
+// setAny sets the value of the column col on empty interface elem.
+func (r *Reader) setAny(elem reflect.Value, col, value string) error {
+	if value == "" {
+		elem.Set(reflect.Zero(elem.Type()))
+		return nil
+	}
+
+	var v interface{}
+	var err error
+	switch r.colTypes[col] {
+	case TypeString:
+		v = value
+	case TypeInt:
+		v, err = strconv.ParseInt(value, 10, 64)
+	case TypeFloat:
+		v, err = strconv.ParseFloat(value, 64)
+	case TypeBool:
+		v, err = strconv.ParseBool(r.boolTr(value))
+	default:
+		v = inferValue(r.boolTr(value), value)
+	}
+	if err != nil {
+		return err
+	}
+	elem.Set(reflect.ValueOf(v))
+	return nil
+}
+
+// inferValue returns value parsed as the first matching type. The tr is
+// the value with custom bool values translated.
+func inferValue(tr, value string) interface{} {
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	if b, err := strconv.ParseBool(tr); err == nil {
+		return b
+	}
+	return value
+}
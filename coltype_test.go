@@ -0,0 +1,41 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type anyRow struct {
+	AccountID interface{} `csv:"account_id"`
+	Amount    interface{}
+	Active    interface{}
+	Note      interface{}
+}
+
+func Test_SetDataAny(t *testing.T) {
+	// Prepare test
+	c := FromString("00123,1.5,Y,hello\n7,2,N,").CustomBool([]string{"Y"}, []string{"N"})
+
+	// Start test
+	v := &anyRow{}
+	assert.NotError(t, c.SetData(v))
+	assert.Equal(t, anyRow{AccountID: int64(123), Amount: 1.5, Active: true, Note: "hello"}, *v)
+
+	assert.NotError(t, c.SetData(v))
+	assert.Equal(t, anyRow{AccountID: int64(7), Amount: int64(2), Active: false, Note: nil}, *v)
+	assert.Equal(t, "7,2,N,", ToCsv(v, ",", "Y", "N"))
+	assert.Equal(t, 0, len(c.UnboundFields(v)))
+}
+
+func Test_ColumnType(t *testing.T) {
+	// Prepare test
+	c := FromString("00123,1,1,x\n00124,x,0,y").ColumnType("account_id", TypeString).ColumnType("Amount", TypeFloat).ColumnType("Active", TypeBool)
+
+	// Start test
+	v := &anyRow{}
+	assert.NotError(t, c.SetData(v))
+	assert.Equal(t, anyRow{AccountID: "00123", Amount: float64(1), Active: true, Note: "x"}, *v)
+	assert.NotNil(t, c.SetData(v))
+
+	assert.Panic(t, func() { FromString("").ColumnType("a", "date") }, "Column a: unknown type date")
+}
@@ -0,0 +1,64 @@
+package csvutil
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ReadColumn reads values of the named column of CSV file from r and
+// appends them to the slice pointed to by v. The first record is the
+// header and the dialect is detected. Slice elements may be of any type
+// supported by SetData, e.g. string, int or float64.
+//
+// Example:
+//
+//	var ids []int
+//	err := csvutil.ReadColumn(f, "id", &ids)
+func ReadColumn(r io.Reader, column string, v interface{}) error {
+	ptr := reflect.ValueOf(v)
+	if ptr.Kind() != reflect.Ptr || ptr.Elem().Kind() != reflect.Slice {
+		panic("Expected pointer to a slice")
+	}
+	slice := ptr.Elem()
+	elemType := slice.Type().Elem()
+
+	d, br, err := sniffDialect(r)
+	if err != nil {
+		return err
+	}
+	c := NewCsvUtil(br).OwnsSource(false).Dialect(d).FieldsPerRecord(-1)
+
+	header, err := c.read()
+	if err == io.EOF {
+		return fmt.Errorf("column %s is not in the header", column)
+	}
+	if err != nil {
+		return err
+	}
+	idx := indexOf(header, column)
+	if idx < 0 {
+		return fmt.Errorf("column %s is not in the header", column)
+	}
+
+	for {
+		record, err := c.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		value := cell(record, idx)
+		if d.Null != "" && value == d.Null {
+			value = ""
+		}
+		elem := reflect.New(elemType).Elem()
+		if err = c.set(elem, column, value); err != nil {
+			line, _ := c.fieldPos(0)
+			return &FieldError{Line: line, Column: column, Value: value, Err: err}
+		}
+		slice.Set(reflect.Append(slice, elem))
+	}
+}
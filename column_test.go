@@ -0,0 +1,45 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+func Test_ReadColumn(t *testing.T) {
+	// Prepare test
+	data := "id;name;score\n1;Tony;1.5\n2;John;\n3;Ann;2\n"
+
+	// Start test
+	var names []string
+	assert.NotError(t, ReadColumn(strings.NewReader(data), "name", &names))
+	assert.Equal(t, []string{"Tony", "John", "Ann"}, names)
+
+	var ids []int
+	assert.NotError(t, ReadColumn(strings.NewReader(data), "id", &ids))
+	assert.Equal(t, []int{1, 2, 3}, ids)
+
+	var scores []*float64
+	assert.NotError(t, ReadColumn(strings.NewReader(data), "score", &scores))
+	assert.Equal(t, 3, len(scores))
+	assert.Equal(t, 1.5, *scores[0])
+	assert.Equal(t, true, scores[1] == nil)
+
+	single := []string{"x"}
+	assert.NotError(t, ReadColumn(strings.NewReader("email\na@x\nb@x\n"), "email", &single))
+	assert.Equal(t, []string{"x", "a@x", "b@x"}, single)
+}
+
+func Test_ReadColumnErrors(t *testing.T) {
+	// Prepare test
+	var ids []int
+
+	// Start test
+	assert.NotNil(t, ReadColumn(strings.NewReader("id\n1\n"), "ID", &ids))
+
+	err := ReadColumn(strings.NewReader("id\n1\nx\n"), "id", &ids)
+	assert.Equal(t, 3, err.(*FieldError).Line)
+	assert.Equal(t, []int{1}, ids)
+
+	assert.Panic(t, func() { ReadColumn(strings.NewReader("id\n1\n"), "id", ids) }, "Expected pointer to a slice")
+}
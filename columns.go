@@ -0,0 +1,25 @@
+package csvutil
+
+// ExpectColumns makes Reader check that the header and every record have
+// exactly n columns. Records with different number of columns fail with
+// ColumnCountError which includes the file header to make import logs
+// easier to read. Zero disables the check.
+func (r *Reader) ExpectColumns(n int) *Reader {
+	r.expect = n
+	if n > 0 {
+		// The check replaces the one of CSV reader.
+		r.csvr.FieldsPerRecord = -1
+	}
+	return r
+}
+
+// columnCountError returns ColumnCountError for the current record.
+func (r *Reader) columnCountError() *ColumnCountError {
+	line, _ := r.fieldPos(0)
+	header := r.columns
+	if header == nil && r.headerRow {
+		// The current record is the header.
+		header = r.csvLine
+	}
+	return &ColumnCountError{Line: line, Expected: r.expect, Got: len(r.csvLine), Header: header}
+}
@@ -0,0 +1,32 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type threeCols struct {
+	Name  string
+	Age   int
+	Email string
+}
+
+func Test_ExpectColumns(t *testing.T) {
+	// Prepare test
+	c := FromString("Name,Age,Email\nTony,23,t@x.com\nJohn,34\n").HeaderFromFirstRow().ExpectColumns(3)
+
+	// Start test
+	got := &threeCols{}
+	assert.NotError(t, c.SetData(got))
+	err := c.SetData(got)
+	assert.Equal(t, "line 3: expected 3 columns, got 2 (header: Name,Age,Email)", err.Error())
+}
+
+func Test_ExpectColumnsHeader(t *testing.T) {
+	// Start test
+	err := FromString("Name,Age\nTony,23\n").HeaderFromFirstRow().ExpectColumns(3).SetData(&threeCols{})
+	assert.Equal(t, &ColumnCountError{Line: 1, Expected: 3, Got: 2, Header: []string{"Name", "Age"}}, err)
+
+	err = FromString("Tony,23\n").ExpectColumns(3).SetData(&threeCols{})
+	assert.Equal(t, "line 1: expected 3 columns, got 2", err.Error())
+}
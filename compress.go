@@ -0,0 +1,90 @@
+package csvutil
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"sync"
+)
+
+// Compressor returns writer compressing data written to w. Closing the
+// writer must finish the compressed stream without closing w.
+type Compressor func(w io.Writer) (io.WriteCloser, error)
+
+// Compression formats of Export.Compress.
+const (
+	Gzip = "gzip"
+	Zstd = "zstd"
+)
+
+// ErrNoCompressor is returned when output should be compressed with format
+// no compressor has been registered for.
+var ErrNoCompressor = errors.New("no compressor registered for output format")
+
+// DictCompressor returns writer compressing data written to w with
+// dictionary trained on samples, each of them a single CSV record.
+type DictCompressor func(w io.Writer, samples [][]byte) (io.WriteCloser, error)
+
+// Registered compressors. Zstandard has no compressor by default to keep
+// the package free of external dependencies.
+var (
+	compressorsMu sync.RWMutex
+	compressors   = map[string]Compressor{
+		Gzip: func(w io.Writer) (io.WriteCloser, error) { return gzip.NewWriter(w), nil },
+	}
+	dictCompressors = map[string]DictCompressor{}
+)
+
+// RegisterCompressor registers compressor of the format. Registering known
+// format replaces its compressor.
+//
+// Example:
+//
+//	// Using github.com/klauspost/compress/zstd.
+//	csvutil.RegisterCompressor(csvutil.Zstd, func(w io.Writer) (io.WriteCloser, error) {
+//		return zstd.NewWriter(w)
+//	})
+func RegisterCompressor(format string, fn Compressor) {
+	compressorsMu.Lock()
+	defer compressorsMu.Unlock()
+	compressors[format] = fn
+}
+
+// RegisterDictCompressor registers compressor of the format which uses
+// dictionaries, see Export.CompressDict. No such compressor is registered
+// by default.
+//
+// Example:
+//
+//	// Using github.com/klauspost/compress/zstd.
+//	csvutil.RegisterDictCompressor(csvutil.Zstd, func(w io.Writer, samples [][]byte) (io.WriteCloser, error) {
+//		dict, err := zstd.BuildDict(zstd.BuildDictOptions{Contents: samples, ...})
+//		if err != nil {
+//			return nil, err
+//		}
+//		return zstd.NewWriter(w, zstd.WithEncoderDict(dict))
+//	})
+func RegisterDictCompressor(format string, fn DictCompressor) {
+	compressorsMu.Lock()
+	defer compressorsMu.Unlock()
+	dictCompressors[format] = fn
+}
+
+// compress returns writer compressing data written to w with format. The
+// dictionary compressor is used when samples are not nil.
+func compress(w io.Writer, format string, samples [][]byte) (io.WriteCloser, error) {
+	compressorsMu.RLock()
+	fn := compressors[format]
+	dfn := dictCompressors[format]
+	compressorsMu.RUnlock()
+	if samples != nil {
+		if dfn == nil {
+			return nil, ErrNoCompressor
+		}
+		return dfn(w, samples)
+	}
+	if fn == nil {
+		return nil, ErrNoCompressor
+	}
+	return fn(w)
+}
@@ -0,0 +1,120 @@
+package csvutil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"testing"
+)
+
+func Test_ExportCompress(t *testing.T) {
+	// Prepare test
+	people := []person2{{Name: "Tony", Balance: 1.5}, {Name: "John", Balance: 2}}
+	var buf bytes.Buffer
+
+	// Start test
+	n, err := NewExport(people, Dialect{}).Compress(Gzip).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+
+	rc, err := Decompress(ioutil.NopCloser(&buf))
+	assert.NotError(t, err)
+	data, err := ioutil.ReadAll(rc)
+	assert.NotError(t, err)
+	assert.Equal(t, "Tony,1.5\nJohn,2\n", string(data))
+}
+
+func Test_RegisterCompressor(t *testing.T) {
+	// Prepare test
+	var buf bytes.Buffer
+	people := []person2{{Name: "Tony", Balance: 1.5}}
+
+	// Start test
+	_, err := NewExport(people, Dialect{}).Compress(Zstd).WriteTo(&buf)
+	assert.Equal(t, ErrNoCompressor, err)
+
+	RegisterCompressor(Zstd, func(w io.Writer) (io.WriteCloser, error) {
+		return gzip.NewWriterLevel(w, gzip.BestSpeed)
+	})
+	defer RegisterCompressor(Zstd, nil)
+	_, err = NewExport(people, Dialect{}).Compress(Zstd).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, GzipMagic, buf.Bytes()[:2])
+}
+
+func Test_ExportCompressDict(t *testing.T) {
+	// Prepare test
+	var people []person2
+	for i := 0; i < 10; i++ {
+		people = append(people, person2{Name: "P" + strconv.Itoa(i), Balance: float32(i)})
+	}
+	var got [][]byte
+	var buf bytes.Buffer
+
+	// Start test
+	_, err := NewExport(people, Dialect{}).Compress(Gzip).CompressDict(3).WriteTo(&buf)
+	assert.Equal(t, ErrNoCompressor, err)
+
+	RegisterDictCompressor(Gzip, func(w io.Writer, samples [][]byte) (io.WriteCloser, error) {
+		got = samples
+		return gzip.NewWriter(w), nil
+	})
+	defer RegisterDictCompressor(Gzip, nil)
+	_, err = NewExport(people, Dialect{}).Compress(Gzip).CompressDict(3).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, [][]byte{[]byte("P0,0\n"), []byte("P3,3\n"), []byte("P6,6\n")}, got)
+}
+
+// closeWriter records if it was closed.
+type closeWriter struct {
+	io.Writer
+	closed bool
+}
+
+func (w *closeWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func Test_ExportCompressClose(t *testing.T) {
+	// Prepare test
+	var zw *closeWriter
+	RegisterCompressor(Zstd, func(w io.Writer) (io.WriteCloser, error) {
+		zw = &closeWriter{Writer: w}
+		return zw, nil
+	})
+	defer RegisterCompressor(Zstd, nil)
+	var buf bytes.Buffer
+
+	// Start test
+	_, err := NewExport([]secretPerson{{SSN: "abc"}}, Dialect{}).Compress(Zstd).WriteTo(&buf)
+	assert.Equal(t, "Wasn't able to get value for field: SSN: "+ErrNoCipher.Error(), err.Error())
+	assert.Equal(t, true, zw.closed)
+
+	zw = nil
+	_, err = NewExport([]person2{{Name: "Tony"}}, Dialect{}).Compress(Zstd).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, true, zw.closed)
+}
+
+func Test_ExportCompressMixedHeader(t *testing.T) {
+	// Prepare test
+	var zw *closeWriter
+	RegisterCompressor(Zstd, func(w io.Writer) (io.WriteCloser, error) {
+		zw = &closeWriter{Writer: w}
+		return zw, nil
+	})
+	defer RegisterCompressor(Zstd, nil)
+	rows := []interface{}{&fileHeader{Origin: "ACME"}}
+	var buf bytes.Buffer
+
+	// Start test
+	n, err := NewExport(rows, Dialect{}).TypeSwitch("record_type", nachaTypes).Header(true).ExcelCompatible(false).Compress(Zstd).WriteTo(&buf)
+	assert.Equal(t, "export: header of mixed row types", err.Error())
+	assert.Equal(t, int64(0), n)
+	assert.Equal(t, 0, buf.Len())
+	assert.Equal(t, true, zw == nil)
+}
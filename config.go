@@ -0,0 +1,135 @@
+package csvutil
+
+import (
+	"io"
+	"reflect"
+)
+
+// Config is immutable Reader configuration safe to share between
+// goroutines. Readers created from it don't share any state so each
+// goroutine can decode its own stream.
+//
+// Example:
+//
+//	cfg := csvutil.NewCsvUtil(nil).Comma(';').Null("NULL").HeaderFromFirstRow().Config()
+//	for _, f := range files {
+//		go func(f io.Reader) {
+//			c := cfg.NewReader(f)
+//			// Decode records with c.SetData
+//		}(f)
+//	}
+type Config struct {
+	r Reader
+}
+
+// Config returns snapshot of the Reader configuration. Input filters like
+// Checksum, Throttle or VerifyFingerprint belong to the stream and are not
+// part of the configuration. Must be called before the first record is read.
+func (r *Reader) Config() *Config {
+	return &Config{r: r.cloneConfig()}
+}
+
+// NewReader returns new Reader reading from rc configured with c.
+func (c *Config) NewReader(rc io.Reader) *Reader {
+	r := c.r.cloneConfig()
+	r.csvr = newCsvReader(rc, c.r.csvr)
+	r.src = rc
+	r.csvReader, _ = rc.(io.Closer)
+	if r.whitespace == WhitespaceTrimUnquoted || r.thousands {
+		r.tapInput()
+	}
+	return &r
+}
+
+// cloneConfig returns Reader with configuration of r not sharing any
+// mutable state with it. The stream and state of reading are not copied.
+func (r *Reader) cloneConfig() Reader {
+	c := *r
+	c.csvr = newCsvReader(nil, r.csvr)
+
+	// State of reading the stream.
+	c.csvLine = nil
+	c.tap = nil
+	c.row = 0
+	c.offset = 0
+	c.base = 0
+	c.lines = 0
+	c.columns = nil
+	c.mapped = false
+	c.changes = nil
+	c.interned = nil
+	c.warnedExtra = false
+	c.pending = nil
+	c.key = ""
+	c.switchIdx = -1
+	c.peeked = false
+	c.sortPrev = reflect.Value{}
+	c.seqPrev = 0
+	c.seqSeen = false
+	c.src = nil
+	c.hash = nil
+	c.scrub = nil
+	c.csvReader = nil
+	if !r.customHeader {
+		c.header = nil
+	}
+
+	// Slices are capped so appending to them allocates new arrays.
+	c.rowChecks = r.rowChecks[:len(r.rowChecks):len(r.rowChecks)]
+	c.fieldHooks = r.fieldHooks[:len(r.fieldHooks):len(r.fieldHooks)]
+	c.rowHooks = r.rowHooks[:len(r.rowHooks):len(r.rowHooks)]
+	c.keyCols = r.keyCols[:len(r.keyCols):len(r.keyCols)]
+	c.mapping = r.mapping[:len(r.mapping):len(r.mapping)]
+
+	c.customTBool = make(map[string]struct{}, len(r.customTBool))
+	for k := range r.customTBool {
+		c.customTBool[k] = struct{}{}
+	}
+	c.customFBool = make(map[string]struct{}, len(r.customFBool))
+	for k := range r.customFBool {
+		c.customFBool[k] = struct{}{}
+	}
+	if r.customHeader {
+		c.header = make(CsvHeader, len(r.header))
+		for k, v := range r.header {
+			c.header[k] = v
+		}
+	}
+	if r.protoEnums != nil {
+		c.protoEnums = make(protoEnums, len(r.protoEnums))
+		for k, v := range r.protoEnums {
+			c.protoEnums[k] = v
+		}
+	}
+	if r.translations != nil {
+		c.translations = make(map[string]string, len(r.translations))
+		for k, v := range r.translations {
+			c.translations[k] = v
+		}
+	}
+	if r.convs != nil {
+		c.convs = make(columnConverters, len(r.convs))
+		for k, v := range r.convs {
+			c.convs[k] = v[:len(v):len(v)]
+		}
+	}
+	if r.colTypes != nil {
+		c.colTypes = make(map[string]string, len(r.colTypes))
+		for k, v := range r.colTypes {
+			c.colTypes[k] = v
+		}
+	}
+	if r.sets != nil {
+		c.sets = make(map[string]valueSet, len(r.sets))
+		for k, v := range r.sets {
+			c.sets[k] = v
+		}
+	}
+	if r.switchTypes != nil {
+		c.switchTypes = make(RowTypes, len(r.switchTypes))
+		for k, v := range r.switchTypes {
+			c.switchTypes[k] = v
+		}
+	}
+	return c
+}
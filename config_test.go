@@ -0,0 +1,74 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type shared struct {
+	Name    string
+	Age     int
+	Active  bool
+	Created time.Time
+}
+
+func Test_Config(t *testing.T) {
+	// Prepare test
+	cfg := NewCsvUtil(nil).Comma(';').CustomBool([]string{"Y"}, []string{"N"}).
+		ColumnConverter("Name", converters["upper"]).Config()
+
+	// Start test
+	var wg sync.WaitGroup
+	names := make([]string, 8)
+	for i := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := cfg.NewReader(strings.NewReader("tony" + strconv.Itoa(i) + ";" + strconv.Itoa(i) + ";Y;2016-01-02T15:04:05Z"))
+			p := &shared{}
+			if err := c.SetData(p); err == nil && p.Age == i && p.Active {
+				names[i] = p.Name
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i, name := range names {
+		assert.Equal(t, "TONY"+strconv.Itoa(i), name)
+	}
+}
+
+func Test_ConfigIsolation(t *testing.T) {
+	// Prepare test
+	r := NewCsvUtil(nil).CustomBool([]string{"Y"}, nil)
+	cfg := r.Config()
+
+	// Start test
+	r.CustomBool([]string{"T"}, nil)
+	c := cfg.NewReader(strings.NewReader("a,1,T,2016-01-02T15:04:05Z"))
+	c.CustomBool([]string{"J"}, nil)
+
+	assert.Equal(t, map[string]struct{}{"Y": {}}, cfg.r.customTBool)
+	assert.Equal(t, map[string]struct{}{"Y": {}, "J": {}}, c.customTBool)
+}
+
+func Test_ConfigStreamState(t *testing.T) {
+	// Prepare test
+	r := NewCsvUtil(strings.NewReader("Name,Balance\n Tony ,2\n")).HeaderFromFirstRow().Audit(true).Whitespace(WhitespaceTrimAll)
+	p := &person2{}
+	assert.NotError(t, r.SetData(p))
+
+	// Start test
+	c := r.Config().NewReader(strings.NewReader("Balance,Name\n1.5,John \n"))
+	assert.Equal(t, 0, c.row)
+	assert.Equal(t, []string(nil), c.Columns())
+	assert.Equal(t, []Change(nil), c.Changes())
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person2{Name: "John", Balance: 1.5}, *p)
+	assert.Equal(t, 1, len(c.Changes()))
+	assert.Equal(t, true, c.audit)
+}
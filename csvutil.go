@@ -9,14 +9,18 @@
 package csvutil
 
 import (
+	"bytes"
 	"encoding"
 	"encoding/csv"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Structure fields cache.
@@ -28,6 +32,9 @@ type CsvHeader map[string]int
 // CSV headers cache.
 var hCache map[string]CsvHeader
 
+// Guards fCache and hCache.
+var cacheMu sync.Mutex
+
 var textUnmarshalerType = reflect.TypeOf(new(encoding.TextUnmarshaler)).Elem()
 
 // Provides primitives to read CSV file and set values on structures.
@@ -39,17 +46,84 @@ type Reader struct {
 	customTBool  map[string]struct{} // Custom true values
 	customFBool  map[string]struct{} // Custom false values
 	trim         string              // Characters to trim
-	csvReader    io.ReadCloser
+	null         string              // Value representing empty column
+	proto        bool                // True if decoding into protobuf structures
+	protoEnums   protoEnums          // Protobuf enum values by name
+	lenient      bool                // Accept exponent and digit separators in numbers
+	coerce       Coercions           // Allowed conversions between types
+	whitespace   Whitespace          // Whitespace policy for values
+	tap          *rawTap             // Raw input of the current record
+	row          int                 // Number of records read so far
+	skip         int                 // Number of records to skip
+	offset       int64               // Input offset of the current record
+	base         int64               // Input offset reading started at
+	lines        int                 // Number of lines before the reading started
+	source       string              // Name of the input
+	headerRow    bool                // True if the first record is the header
+	columns      []string            // Column names in the order of the CSV header
+	translations map[string]string   // Header names translations
+	mapping      Mapping             // Runtime mapping of columns to fields
+	mapped       bool                // True if mapping has been applied
+	convs        columnConverters    // Converters by column name
+	rowChecks    []RowValidator      // Validators run on populated structures
+	sets         map[string]valueSet // Allowed values by field name
+	audit        bool                // True if value changes are recorded
+	changes      []Change            // Value changes in the current record
+	retries      int                 // Number of times Batches retries failed batch
+	discard      bool                // True if SetData does not populate structures
+	interned     internTables        // Interned values by column name
+	noCache      bool                // True if header is not cached between Readers
+	expect       int                 // Expected number of columns, 0 if not checked
+	sentinel     SentinelMatcher     // Matches records separating batches
+	onWarning    func(Warning)       // Receives non-fatal findings
+	warnedExtra  bool                // True if ignored columns have been reported
+	pending      []interface{}       // Batch rejected by Batches callback
+	keyed        bool                // True if records get idempotency key
+	keyCols      []string            // Columns idempotency key is derived from
+	key          string              // Idempotency key of the current record
+	ignoreSep    bool                // True if the sep= directive line is data
+	numbered     bool                // True if columns are named by position
+	thousands    bool                // Accept thousands separators in quoted numbers
+	colTypes     map[string]string   // Types of values set on interface{} fields by column name
+	switchCol    string              // Column deciding the row type
+	switchTypes  RowTypes            // Row types by value of the switch column
+	switchIdx    int                 // Index of the switch column, -1 until known
+	peeked       bool                // True if read returns the current record again
+	sortField    string              // Field records must be sorted by
+	sortPrev     reflect.Value       // Greatest value of the sort field so far
+	seqField     string              // Field with consecutive numbers
+	seqPrev      int64               // Greatest value of the sequence field so far
+	seqSeen      bool                // True if seqPrev is set
+	assertWarn   bool                // True if failed assertions are warnings
+	fieldHooks   []FieldHook         // Run on column values before they are set
+	rowHooks     []RowHook           // Run on populated structures
+	src          io.Reader           // The stream CSV reader reads from
+	hash         hash.Hash           // Checksum of the raw input
+	scrub        *scrubReader        // Removes control characters from the input
+	owns         bool                // True if Close closes the input
+	csvReader    io.Closer
 }
 
-// NewCsvUtil returns new Reader.
-func NewCsvUtil(rc io.ReadCloser) *Reader {
-	reader := &Reader{csvr: csv.NewReader(rc)}
+// NewCsvUtil returns new Reader. If rc implements io.Closer
+// it is closed by Reader.Close, see OwnsSource.
+func NewCsvUtil(rc io.Reader) *Reader {
+	reader := &Reader{csvr: csv.NewReader(rc), src: rc, owns: true, coerce: DefaultCoercions}
+	reader.csvReader, _ = rc.(io.Closer)
 	reader.customTBool = make(map[string]struct{})
 	reader.customFBool = make(map[string]struct{})
 	return reader
 }
 
+// FromString returns new Reader reading CSV data from s.
+func FromString(s string) *Reader {
+	return NewCsvUtil(strings.NewReader(s))
+}
+
+// FromBytes returns new Reader reading CSV data from b.
+func FromBytes(b []byte) *Reader {
+	return NewCsvUtil(bytes.NewReader(b))
+}
+
 // Comma sets field delimiter (default: ',').
 func (r *Reader) Comma(s rune) *Reader {
 	r.csvr.Comma = s
@@ -103,9 +177,68 @@ func (r *Reader) Trim(t string) *Reader {
 	return r
 }
 
-// Close closes the io stream.
+// Checksum computes hash of the raw input stream while it is being decoded.
+// Must be called before the first record is read. The checksum covers
+// the whole input once SetData returns io.EOF.
+//
+// Example:
+//
+//	c := NewCsvUtil(f).Checksum(sha256.New())
+//	for c.SetData(p) == nil {
+//		// Do work with p
+//	}
+//	sum := c.Sum()
+func (r *Reader) Checksum(h hash.Hash) *Reader {
+	r.hash = h
+	r.wrap(func(src io.Reader) io.Reader {
+		return io.TeeReader(src, h)
+	})
+	return r
+}
+
+// Sum returns checksum of the input read so far or nil if Checksum was not set.
+func (r *Reader) Sum() []byte {
+	if r.hash == nil {
+		return nil
+	}
+	return r.hash.Sum(nil)
+}
+
+// wrap replaces the input stream with the one returned by fn
+// keeping CSV reader configuration. Must be called before the first read.
+func (r *Reader) wrap(fn func(io.Reader) io.Reader) {
+	r.src = fn(r.src)
+	r.csvr = newCsvReader(r.src, r.csvr)
+}
+
+// newCsvReader returns csv.Reader reading from src with settings of csvr.
+func newCsvReader(src io.Reader, csvr *csv.Reader) *csv.Reader {
+	nr := csv.NewReader(src)
+	nr.Comma = csvr.Comma
+	nr.Comment = csvr.Comment
+	nr.FieldsPerRecord = csvr.FieldsPerRecord
+	nr.LazyQuotes = csvr.LazyQuotes
+	nr.TrailingComma = csvr.TrailingComma
+	nr.TrimLeadingSpace = csvr.TrimLeadingSpace
+	return nr
+}
+
+// Null sets value which is treated as empty column, e.g. "NULL" or "\N".
+func (r *Reader) Null(s string) *Reader {
+	r.null = s
+	return r
+}
+
+// OwnsSource sets if Close closes the stream passed to NewCsvUtil (default: true).
+// Set it to false when the stream lifetime is managed elsewhere.
+func (r *Reader) OwnsSource(b bool) *Reader {
+	r.owns = b
+	return r
+}
+
+// Close closes the io stream if the Reader owns it.
 func (r *Reader) Close() error {
-	if r.csvReader != nil {
+	if r.owns && r.csvReader != nil {
 		return r.csvReader.Close()
 	}
 	return nil
@@ -124,11 +257,47 @@ func (r *Reader) boolTr(value string) string {
 
 // read reads one record from CSV file.
 func (r *Reader) read() ([]string, error) {
+	if r.peeked {
+		r.peeked = false
+		return r.csvLine, nil
+	}
+
 	var err error
-	r.csvLine, err = r.csvr.Read()
+	for {
+		r.offset = r.base + r.csvr.InputOffset()
+		if r.tap != nil {
+			r.tap.discard(r.csvr.InputOffset())
+		}
+		fields := r.csvr.FieldsPerRecord
+		r.csvLine, err = r.csvr.Read()
+		if err == nil && r.offset == 0 && !r.ignoreSep && r.sepDirective(r.csvLine) {
+			// The directive must not set the number of fields.
+			r.csvr.FieldsPerRecord = fields
+			continue
+		}
+		if r.csvLine != nil {
+			r.row++
+		}
+		if r.skip == 0 || err != nil {
+			break
+		}
+		r.skip--
+	}
+	if err == nil && r.expect > 0 && len(r.csvLine) != r.expect {
+		err = r.columnCountError()
+	}
+	if err == nil && r.numbered && r.columns == nil {
+		r.numberColumns(r.csvLine)
+	}
 	return r.csvLine, err
 }
 
+// fieldPos returns line and column of the field i of the current record.
+func (r *Reader) fieldPos(i int) (int, int) {
+	line, col := r.csvr.FieldPos(i)
+	return r.lines + line, col
+}
+
 // Header sets CSV header.
 func (r *Reader) Header(h CsvHeader) *Reader {
 	r.header = h
@@ -136,6 +305,14 @@ func (r *Reader) Header(h CsvHeader) *Reader {
 	return r
 }
 
+// CacheHeaders sets if header derived from the structure is cached between
+// Readers (default: true). Headers set with Header, HeaderFromFirstRow or
+// Mapping belong to the Reader and are never cached.
+func (r *Reader) CacheHeaders(b bool) *Reader {
+	r.noCache = !b
+	return r
+}
+
 // SetData sets values from CSV record on passed struct.
 // Returns error or io.EOF when no more records exist.
 func (r *Reader) SetData(v interface{}) error {
@@ -143,49 +320,106 @@ func (r *Reader) SetData(v interface{}) error {
 	var ok bool
 	var strValue string
 
+	structFields, structName := getFields(v)
+	if r.proto {
+		structFields = protoFields(structFields)
+		structName = "proto:" + structName
+	}
+	if len(structFields) == 0 {
+		return &NoFieldsError{Type: structName, Fields: r.unboundFields(v, true)}
+	}
+
+	if r.headerRow && r.columns == nil {
+		if err = r.readHeader(); err != nil {
+			return err
+		}
+	}
+
 	_, err = r.read()
 	if err != nil {
 		return err
 	}
-
-	// Initialize cache if its not there yet
-	if hCache == nil {
-		hCache = make(map[string]CsvHeader)
+	r.changes = nil
+	if r.sentinel != nil && r.sentinel(r.csvLine) {
+		return ErrBatchBoundary
 	}
 
-	structFields, structName := getFields(v)
+	if r.mapping != nil && !r.mapped {
+		if err = r.applyMapping(structFields); err != nil {
+			return err
+		}
+	}
 
-	if !r.customHeader {
+	if !r.customHeader && r.noCache {
+		r.header = getHeaders(structFields)
+	} else if !r.customHeader {
+		cacheMu.Lock()
+		// Initialize cache if its not there yet
+		if hCache == nil {
+			hCache = make(map[string]CsvHeader)
+		}
 		if r.header, ok = hCache[structName]; !ok {
 			r.header = getHeaders(structFields)
 			hCache[structName] = r.header
 		}
+		cacheMu.Unlock()
+	}
+
+	if r.onWarning != nil && !r.warnedExtra {
+		r.warnExtra(structFields)
 	}
 
 	value := reflect.ValueOf(v).Elem()
+	if r.discard {
+		value = reflect.New(value.Type()).Elem()
+	}
 
 	for _, sf := range structFields {
-		strValue = r.colByName(sf.name)
+		// Mapping sets only the fields it lists.
+		if r.mapped && !r.hasColumn(sf.col) {
+			continue
+		}
+
+		if strValue, err = r.colValue(sf); err != nil {
+			return r.fieldFailed(sf, strValue, err)
+		}
+		if strValue, err = r.beforeField(sf, strValue); err != nil {
+			return r.fieldFailed(sf, strValue, err)
+		}
+
+		if sf.setter != "" {
+			if err = callSetter(value, sf, strValue); err != nil {
+				return r.fieldFailed(sf, strValue, r.fieldError(sf, strValue, err))
+			}
+			if err = r.validate(sf, strValue); err != nil {
+				return r.fieldFailed(sf, strValue, err)
+			}
+			continue
+		}
 
 		// a little nasty, but if a field implements encoding.TextUnmarshaler, use its UnmarshalText method.
 		if reflect.PtrTo(sf.typ).Implements(textUnmarshalerType) {
 			// TODO: This all could probably be done better.
 
-			if !sf.val.CanAddr() {
+			// Unmarshal directly into v, sf.val belongs to the struct fields were cached from.
+			field := value.FieldByName(sf.name)
+			if !field.CanAddr() {
 				return fmt.Errorf("the field '%s' implements encoding.TextUnmarshaler but it is unaddressable.", sf.name)
 			}
 
-			ut, _ := sf.val.Addr().Interface().(encoding.TextUnmarshaler)
-			err = ut.UnmarshalText([]byte(strValue))
-
-			if !reflect.ValueOf(v).Elem().FieldByName(sf.name).CanSet() {
+			if !field.CanSet() {
 				return fmt.Errorf("unable to set field '%s'.", sf.name)
 			}
 
-			reflect.ValueOf(v).Elem().FieldByName(sf.name).Set(reflect.ValueOf(ut).Elem())
+			ut, _ := field.Addr().Interface().(encoding.TextUnmarshaler)
+			err = unmarshalText(ut, strValue)
 
 			if err != nil {
-				return err
+				return r.fieldFailed(sf, strValue, err)
+			}
+
+			if err = r.validate(sf, strValue); err != nil {
+				return r.fieldFailed(sf, strValue, err)
 			}
 
 			continue
@@ -194,11 +428,31 @@ func (r *Reader) SetData(v interface{}) error {
 		err = r.setValue(value, sf, strValue)
 
 		if err != nil {
+			return r.fieldFailed(sf, strValue, err)
+		}
+
+		if err = r.validate(sf, strValue); err != nil {
+			return r.fieldFailed(sf, strValue, err)
+		}
+	}
+
+	if r.keyed {
+		if r.key, err = r.recordKey(); err != nil {
 			return err
 		}
 	}
 
-	return err
+	r.setRowMeta(value)
+
+	if err = r.assertRow(value); err != nil {
+		return err
+	}
+
+	if err = r.validateRow(value.Addr().Interface()); err != nil {
+		return err
+	}
+
+	return r.afterRow(value.Addr().Interface())
 }
 
 // LastCsvLine returns most recent CSV line that has been read from the io.Reader.
@@ -206,15 +460,60 @@ func (r *Reader) LastCsvLine() string {
 	return strings.Join(r.csvLine, string(r.csvr.Comma))
 }
 
+// hasColumn returns true if column is present in the header.
+func (r *Reader) hasColumn(col string) bool {
+	_, ok := r.header[col]
+	return ok
+}
+
+// colValue returns value of CSV column mapped to the structure field.
+func (r *Reader) colValue(f *sField) (string, error) {
+	var err error
+	value := r.colByName(f.col)
+	var conv string
+	for _, fn := range r.convs[f.col] {
+		if conv, err = fn(value); err != nil {
+			return "", r.fieldError(f, value, err)
+		}
+		r.note(f.col, ChangeConvert, value, conv)
+		value = conv
+	}
+	if f.opts.has("id") {
+		conv = fromExcelText(value)
+		r.note(f.col, ChangeID, value, conv)
+		value = conv
+	}
+	if f.opts.has("cents") && value != "" {
+		if conv, err = toMinor(value, 2); err != nil {
+			return "", r.fieldError(f, value, err)
+		}
+		r.note(f.col, ChangeCents, value, conv)
+		value = conv
+	}
+	if f.opts.has("encrypted") && value != "" {
+		return r.decrypt(f, value)
+	}
+	if f.opts.has("intern") {
+		value = r.intern(f.col, value)
+	}
+	return value, nil
+}
+
 // colByName returns CSV column value by name.
 func (r *Reader) colByName(colName string) string {
 
 	if h, hok := r.header[colName]; hok {
 		if h+1 <= len(r.csvLine) {
+			value := r.trimSpace(h, r.csvLine[h])
 			if r.trim != "" {
-				return strings.Trim(r.csvLine[h], r.trim)
+				value = strings.Trim(value, r.trim)
 			}
-			return r.csvLine[h]
+			r.note(colName, ChangeTrim, r.csvLine[h], value)
+			if r.null != "" && value == r.null {
+				r.note(colName, ChangeNull, value, "")
+				value = ""
+			}
+			return value
 		}
 		panic("Struct has more Fields than represented in CSV")
 	}
@@ -224,10 +523,32 @@ func (r *Reader) colByName(colName string) string {
 // ToCsv takes a struct and returns CSV line with data delimited by delim and
 // true, false values translated to boolTrue, boolFalse respectively.
 func ToCsv(v interface{}, delim, boolTrue, boolFalse string) string {
+	line, err := toCsv(v, delim, boolTrue, boolFalse, nil)
+	if err != nil {
+		panic(err.Error())
+	}
+	return line
+}
+
+// toCsv returns CSV line for the struct v adding truncated values to the report.
+func toCsv(v interface{}, delim, boolTrue, boolFalse string, report *[]Truncation) (string, error) {
+	record, err := toRecord(v, boolTrue, boolFalse, true, false, report)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(record, delim), nil
+}
+
+// toRecord returns CSV values of the struct v adding truncated values to the report.
+// Identifier columns are quoted only if quote is true, records passed to
+// csv.Writer must be left unquoted as the writer quotes them itself. Times
+// are written in UTC if utc is true.
+func toRecord(v interface{}, boolTrue, boolFalse string, quote, utc bool, report *[]Truncation) ([]string, error) {
 	var csvLine []string
 	var strValue string
 	var structField reflect.StructField
 	var field reflect.Value
+	var err error
 
 	t := reflect.ValueOf(v)
 
@@ -243,26 +564,103 @@ func ToCsv(v interface{}, delim, boolTrue, boolFalse string) string {
 		structField = t.Type().Field(i)
 		field = t.Field(i)
 
+		if isRowMeta(structField) {
+			continue
+		}
+
+		// Embedded fields follow the same rules as Headers so values
+		// stay aligned with the header.
 		if structField.Anonymous {
-			strValue = ToCsv(field.Interface(), delim, boolTrue, boolFalse)
-			csvLine = append(csvLine, strValue)
+			et := structField.Type
+			if et.Kind() == reflect.Ptr {
+				et = et.Elem()
+			}
+			if et.Kind() != reflect.Struct || structField.PkgPath != "" {
+				continue
+			}
+			var embedded []string
+			if field.Kind() == reflect.Ptr && field.IsNil() {
+				var fields []reflect.StructField
+				if fields, err = columnFields(et); err != nil {
+					return nil, err
+				}
+				embedded = make([]string, len(fields))
+			} else if embedded, err = toRecord(field.Interface(), boolTrue, boolFalse, quote, utc, report); err != nil {
+				return nil, err
+			}
+			csvLine = append(csvLine, embedded...)
 			continue
 		}
 
 		if !skip(structField.Tag) && field.CanInterface() {
-			strValue = getValue(field, boolTrue, boolFalse)
+			col, opts := parseTag(structField)
+			if strValue, err = fieldText(field, boolTrue, boolFalse, utc); err != nil {
+				return nil, errors.New("Wasn't able to get value for field: " + structField.Name + ": " + err.Error())
+			}
+			if expr, ok := opts.get("omitif"); ok {
+				var omit bool
+				if omit, err = omitIf(t, expr); err != nil {
+					return nil, errors.New("Wasn't able to get value for field: " + structField.Name + ": " + err.Error())
+				}
+				if omit {
+					strValue = ""
+				}
+			}
+			strValue, err = outValue(col, opts, strValue, quote, report)
+			if err != nil {
+				return nil, errors.New("Wasn't able to get value for field: " + structField.Name + ": " + err.Error())
+			}
 			csvLine = append(csvLine, strValue)
 		}
 	}
 
-	return strings.Join(csvLine, delim)
+	return csvLine, nil
+}
+
+// outValue applies csv tag options of the column to the value written to CSV.
+// Truncated values are added to the report if it's not nil. Identifiers are
+// quoted if quote is true, Excel text formulas always.
+func outValue(col string, opts tagOptions, value string, quote bool, report *[]Truncation) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+	var err error
+	if spec, ok := opts.get("unit"); ok {
+		if value, err = unitOut(spec, value); err != nil {
+			return "", err
+		}
+	}
+	if opts.has("cents") {
+		value = fromMinor(value, 2)
+	}
+	if opts.has("pseudonymize") {
+		if value, err = pseudonymize(value); err != nil {
+			return "", err
+		}
+	}
+	// Width limits the plain value so all writers agree on it.
+	if value, err = limitWidth(col, opts, value, report); err != nil {
+		return "", err
+	}
+	if opts.has("encrypted") {
+		if value, err = encrypt(col, value); err != nil {
+			return "", err
+		}
+	} else if mode, ok := opts.get("id"); ok && (quote || mode == "excel") {
+		value = quoteID(value, mode)
+	}
+	return value, nil
 }
 
 // sField described structure field.
 type sField struct {
-	name string
-	typ  reflect.Type
-	val  reflect.Value
+	name   string
+	col    string     // CSV column name
+	opts   tagOptions // Options from the csv tag
+	typ    reflect.Type
+	val    reflect.Value
+	rules  []rule // Constraints from the validate tag
+	setter string // Name of the setter method of unexported field
 }
 
 // getFields returns array of sField for the passed struct.
@@ -279,6 +677,9 @@ func getFields(v interface{}) ([]*sField, string) {
 		panic("Expected pointer to a struct")
 	}
 
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
 	// Initialize cache if its not there yet
 	if fCache == nil {
 		fCache = make(map[string][]*sField)
@@ -294,8 +695,20 @@ func getFields(v interface{}) ([]*sField, string) {
 	var structField reflect.StructField
 	for i := 0; i < t.NumField(); i++ {
 		structField = t.Field(i)
-		if !structField.Anonymous && !skip(structField.Tag) && reflect.ValueOf(v).Elem().Field(i).CanSet() {
-			f := &sField{name: structField.Name, typ: structField.Type, val: reflect.ValueOf(v).Elem().Field(i)}
+		setter := fieldSetter(t, structField)
+		if !structField.Anonymous && !skip(structField.Tag) && (reflect.ValueOf(v).Elem().Field(i).CanSet() || setter != "") {
+			rules, err := parseRules(structField.Tag.Get("validate"))
+			if err != nil {
+				panic("Field " + structField.Name + ": " + err.Error())
+			}
+			col, opts := parseTag(structField)
+			if opts.has("id") && structField.Type.Kind() != reflect.String {
+				panic("Field " + structField.Name + ": id option requires string type")
+			}
+			if spec, ok := opts.get("unit"); ok {
+				checkUnit(structField, spec)
+			}
+			f := &sField{name: structField.Name, col: col, opts: opts, typ: structField.Type, val: reflect.ValueOf(v).Elem().Field(i), rules: rules, setter: setter}
 			structFields = append(structFields, f)
 		}
 	}
@@ -305,87 +718,308 @@ func getFields(v interface{}) ([]*sField, string) {
 	return structFields, structName
 }
 
+// UnboundField describes struct field which will never be set by SetData.
+type UnboundField struct {
+	Name   string // Struct field name
+	Reason string // Why the field will not be set
+}
+
+// UnboundFields returns struct fields of v which will never be populated
+// from CSV data. Fields tagged with skip are not reported.
+func (r *Reader) UnboundFields(v interface{}) []UnboundField {
+	return r.unboundFields(v, false)
+}
+
+// unboundFields returns unbound fields of v including fields tagged
+// with skip if withSkipped is true.
+func (r *Reader) unboundFields(v interface{}, withSkipped bool) []UnboundField {
+	var unbound []UnboundField
+
+	t := reflect.TypeOf(v)
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		panic("Expected pointer to a struct")
+	}
+	t = t.Elem()
+
+	var structField reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		structField = t.Field(i)
+		if skip(structField.Tag) && !withSkipped {
+			continue
+		}
+
+		reason := ""
+		if skip(structField.Tag) {
+			reason = "tagged skip"
+		} else if _, ok := structField.Tag.Lookup("csv"); !ok && tagTypo(structField.Tag) {
+			reason = "malformed csv tag"
+		} else if structField.Anonymous && isRowMeta(structField) {
+			continue
+		} else if structField.Anonymous {
+			reason = "embedded struct"
+		} else if structField.PkgPath != "" && fieldSetter(t, structField) == "" {
+			reason = "unexported"
+		} else if r.proto && strings.HasPrefix(structField.Name, "XXX_") {
+			continue
+		} else if !supported(structField.Type) && !(r.proto && isProtoWrapper(structField.Type)) {
+			reason = "unsupported type " + structField.Type.String()
+		} else if r.customHeader {
+			if col, _ := parseTag(structField); !r.hasColumn(col) {
+				reason = "not in CSV header"
+			}
+		}
+
+		if reason != "" {
+			unbound = append(unbound, UnboundField{Name: structField.Name, Reason: reason})
+		}
+	}
+
+	return unbound
+}
+
+// tagTypo returns true if the tag looks like a misspelled or malformed csv tag.
+func tagTypo(tag reflect.StructTag) bool {
+	for _, kv := range strings.Fields(string(tag)) {
+		key := strings.ToLower(strings.SplitN(kv, ":", 2)[0])
+		if strings.Contains(key, "csv") {
+			return true
+		}
+		if len(key) == 3 && strings.ContainsRune(key, 'c') && strings.ContainsRune(key, 's') && strings.ContainsRune(key, 'v') {
+			return true
+		}
+	}
+	return false
+}
+
+// supported returns true if SetData knows how to set value of type t.
+func supported(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Interface:
+		// Empty interface fields get values inferred or set with ColumnType.
+		return t.NumMethod() == 0
+	}
+	return false
+}
+
+// tagOptions are comma separated options following column name in csv tag.
+type tagOptions []string
+
+// parseTag returns CSV column name and options of the structure field.
+// The column name defaults to the field name.
+//
+// Example:
+//
+//	SSN string `csv:"ssn,encrypted"`
+func parseTag(f reflect.StructField) (string, tagOptions) {
+	parts := strings.Split(csvTag(f.Tag), ",")
+	name := parts[0]
+	if name == "" {
+		name = f.Name
+	}
+	// The errmsg option is the last one and may contain commas.
+	for i := 1; i < len(parts); i++ {
+		if strings.HasPrefix(parts[i], "errmsg=") {
+			parts = append(parts[:i], strings.Join(parts[i:], ","))
+			break
+		}
+	}
+	return name, tagOptions(parts[1:])
+}
+
+// has returns true if option is present.
+func (o tagOptions) has(name string) bool {
+	_, ok := o.get(name)
+	return ok
+}
+
+// get returns value of the option in name=value form.
+func (o tagOptions) get(name string) (string, bool) {
+	for _, opt := range o {
+		kv := strings.SplitN(opt, "=", 2)
+		if kv[0] != name {
+			continue
+		}
+		if len(kv) == 2 {
+			return kv[1], true
+		}
+		return "", true
+	}
+	return "", false
+}
+
 // skip returns true if struct field is tagged with skip.
 func skip(tag reflect.StructTag) bool {
-	return strings.HasPrefix(tag.Get("csv"), "-")
+	return strings.HasPrefix(csvTag(tag), "-")
 }
 
 // getHeaders returns array of CSV column names in order they appear in the record.
 func getHeaders(fields []*sField) CsvHeader {
 	header := make(CsvHeader)
 	for idx, field := range fields {
-		header[field.name] = idx
+		header[field.col] = idx
 	}
 	return header
 }
 
 // setValue sets structure value from CSV column.
-func (r *Reader) setValue(v reflect.Value, f *sField, value string) (err error) {
+func (r *Reader) setValue(v reflect.Value, f *sField, value string) error {
 	elem := v.FieldByName(f.name)
+	if elem.CanSet() && elem.Kind() == reflect.Interface && elem.NumMethod() == 0 {
+		return r.setAny(elem, f.col, value)
+	}
 	if elem.CanSet() {
-		switch f.typ.Kind() {
-		case reflect.String:
-			elem.SetString(value)
-			return
-		case reflect.Int:
-			fallthrough
-		case reflect.Int8:
-			fallthrough
-		case reflect.Int16:
-			fallthrough
-		case reflect.Int32:
-			fallthrough
-		case reflect.Int64:
-			var i64 int64
-			if value == "" {
-				elem.SetInt(0)
-			} else {
-				i64, err = strconv.ParseInt(value, 10, 64)
-				elem.SetInt(i64)
+		err := r.set(elem, f.name, r.stripThousands(elem, f, value))
+		if spec, ok := f.opts.get("unit"); ok && err == nil {
+			applyUnit(elem, spec)
+		}
+		if err == nil && (r.audit || r.onWarning != nil) {
+			r.noteSet(f, elem, value)
+		}
+		return err
+	}
+	return errors.New("Wasn't able to set value on filed: " + f.name + " <- " + value)
+}
+
+// set sets CSV column value on settable elem of the structure field name.
+func (r *Reader) set(elem reflect.Value, name, value string) (err error) {
+	switch elem.Kind() {
+	case reflect.String:
+		elem.SetString(value)
+		return
+	case reflect.Int:
+		fallthrough
+	case reflect.Int8:
+		fallthrough
+	case reflect.Int16:
+		fallthrough
+	case reflect.Int32:
+		fallthrough
+	case reflect.Int64:
+		var i64 int64
+		if value == "" {
+			if !r.coerce.EmptyToZero {
+				return errEmpty
 			}
-			return
-		case reflect.Uint:
-			fallthrough
-		case reflect.Uint8:
-			fallthrough
-		case reflect.Uint16:
-			fallthrough
-		case reflect.Uint32:
-			fallthrough
-		case reflect.Uint64:
-			var u64 uint64
-			if value == "" {
-				elem.SetUint(0)
-			} else {
-				u64, err = strconv.ParseUint(value, 10, 64)
-				elem.SetUint(u64)
+			elem.SetInt(0)
+		} else {
+			i64, err = strconv.ParseInt(value, 10, 64)
+			if err != nil && r.lenient {
+				i64, err = lenientInt(value, err)
 			}
-			return
-		case reflect.Float32:
-			fallthrough
-		case reflect.Float64:
-			var f64 float64
-			if value == "" {
-				elem.SetFloat(f64)
-			} else {
-				f64, err = strconv.ParseFloat(value, 64)
-				elem.SetFloat(f64)
+			if err != nil && r.proto {
+				i64, err = r.protoEnum(elem.Type(), value, err)
+			}
+			if err != nil {
+				i64, err = r.coerceInt(value, err)
 			}
+			elem.SetInt(i64)
+		}
+		return
+	case reflect.Uint:
+		fallthrough
+	case reflect.Uint8:
+		fallthrough
+	case reflect.Uint16:
+		fallthrough
+	case reflect.Uint32:
+		fallthrough
+	case reflect.Uint64:
+		var u64 uint64
+		if value == "" {
+			if !r.coerce.EmptyToZero {
+				return errEmpty
+			}
+			elem.SetUint(0)
+		} else {
+			u64, err = strconv.ParseUint(value, 10, 64)
+			if err != nil && r.lenient {
+				u64, err = lenientUint(value, err)
+			}
+			if err != nil {
+				u64, err = r.coerceUint(value, err)
+			}
+			elem.SetUint(u64)
+		}
+		return
+	case reflect.Float32:
+		fallthrough
+	case reflect.Float64:
+		var f64 float64
+		if value == "" {
+			if !r.coerce.EmptyToZero {
+				return errEmpty
+			}
+			elem.SetFloat(f64)
+		} else {
+			f64, err = strconv.ParseFloat(value, 64)
+			if err != nil && r.lenient {
+				f64, err = lenientFloat(value, err)
+			}
+			elem.SetFloat(f64)
+		}
+		return
+	case reflect.Bool:
+		var b bool
+		value = r.boolTr(value)
+		if !r.coerce.IntToBool && (value == "1" || value == "0") {
+			return &strconv.NumError{Func: "ParseBool", Num: value, Err: strconv.ErrSyntax}
+		}
+		b, err = strconv.ParseBool(value)
+		elem.SetBool(b)
+	case reflect.Ptr:
+		// Empty value leaves the pointer nil.
+		if value == "" {
+			elem.Set(reflect.Zero(elem.Type()))
 			return
-		case reflect.Bool:
-			var b bool
-			b, err = strconv.ParseBool(r.boolTr(value))
-			elem.SetBool(b)
-		default:
-			return errors.New(fmt.Sprintf("Unsupported structure field set %s -> %v.", f.name, value))
 		}
-	} else {
-		return errors.New("Wasn't able to set value on filed: " + f.name + " <- " + value)
+		ptr := reflect.New(elem.Type().Elem())
+		if ut, ok := ptr.Interface().(encoding.TextUnmarshaler); ok {
+			err = unmarshalText(ut, value)
+		} else {
+			err = r.set(ptr.Elem(), name, value)
+		}
+		if err == nil {
+			elem.Set(ptr)
+		}
+	case reflect.Struct:
+		if r.proto && isProtoWrapper(elem.Type()) {
+			return r.set(elem.FieldByName("Value"), name, value)
+		}
+		fallthrough
+	default:
+		return errors.New(fmt.Sprintf("Unsupported structure field set %s -> %v.", name, value))
 	}
 
 	return
 }
 
+// fieldText returns string representation of the struct field. Structures
+// implementing encoding.TextMarshaler are written with MarshalText, times
+// converted to UTC first if utc is true.
+func fieldText(field reflect.Value, boolTrue, boolFalse string, utc bool) (string, error) {
+	if t, ok := field.Interface().(time.Time); ok && utc {
+		field = reflect.ValueOf(t.UTC())
+	}
+	if field.Kind() == reflect.Struct {
+		if m, ok := field.Interface().(encoding.TextMarshaler); ok {
+			b, err := m.MarshalText()
+			return string(b), err
+		}
+	}
+	return getValue(field, boolTrue, boolFalse), nil
+}
+
 // getValue gets string representation of the struct field.
 func getValue(field reflect.Value, boolTrue, boolFalse string) string {
 	switch field.Kind() {
@@ -415,6 +1049,11 @@ func getValue(field reflect.Value, boolTrue, boolFalse string) string {
 		return strconv.FormatFloat(field.Interface().(float64), 'f', -1, 64)
 	case reflect.String:
 		return field.Interface().(string)
+	case reflect.Interface:
+		if field.IsNil() {
+			return ""
+		}
+		return getValue(field.Elem(), boolTrue, boolFalse)
 	case reflect.Bool:
 		if field.Interface().(bool) {
 			return boolTrue
@@ -428,6 +1067,8 @@ func getValue(field reflect.Value, boolTrue, boolFalse string) string {
 
 // StringReadCloser helps with testing in other packages.
 // This satisfies io.ReadCloser interface.
+//
+// Deprecated: Use FromString or FromBytes to read CSV data from memory.
 type StringReadCloser struct {
 	strReader io.Reader
 }
@@ -441,6 +1082,8 @@ func (s *StringReadCloser) Close() error {
 }
 
 // NewStringReadCloser return new StringReadCloser instance.
+//
+// Deprecated: Use FromString or FromBytes to read CSV data from memory.
 func NewStringReadCloser(s string) *StringReadCloser {
 	return &StringReadCloser{strReader: strings.NewReader(s)}
 }
@@ -1,11 +1,14 @@
 package csvutil
 
 import (
+	"crypto/sha256"
+	"errors"
 	"github.com/rzajac/goassert/assert"
 	"io"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 // Stuff to help testing
@@ -318,3 +321,148 @@ func Test_embededToCsv(t *testing.T) {
 // 	fmt.Println(b)
 // 	t.Fail()
 // }
+
+type unboundPerson struct {
+	Name    string
+	age     int
+	Tags    []string
+	Skipped string `csv:"-"`
+	Typo    string `cvs:"-"`
+	Balance float32
+	A
+}
+
+func Test_UnboundFields(t *testing.T) {
+	// Prepare test
+	c := NewCsvUtil(nil)
+
+	// Start test
+	exp := []UnboundField{
+		{Name: "age", Reason: "unexported"},
+		{Name: "Tags", Reason: "unsupported type []string"},
+		{Name: "Typo", Reason: "malformed csv tag"},
+		{Name: "A", Reason: "embedded struct"},
+	}
+	assert.Equal(t, exp, c.UnboundFields(&unboundPerson{}))
+
+	c.Header(CsvHeader{"Name": 0})
+	exp = append(exp, UnboundField{Name: "Balance", Reason: "not in CSV header"})
+	assert.Equal(t, exp[:3], c.UnboundFields(&unboundPerson{})[:3])
+	assert.Equal(t, 5, len(c.UnboundFields(&unboundPerson{})))
+}
+
+func Test_PointerTextUnmarshaler(t *testing.T) {
+	// Prepare test
+	type stamped struct {
+		Name string
+		When *time.Time
+	}
+	c := FromString("Tony,2024-01-02T15:04:05Z\nJohn,\n")
+
+	// Start test
+	assert.Equal(t, 0, len(c.UnboundFields(&stamped{})))
+
+	got := &stamped{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC), *got.When)
+
+	got = &stamped{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, true, got.When == nil)
+}
+
+func Test_Checksum(t *testing.T) {
+	// Prepare test
+	data := strings.Join(testCsvLines, "\n")
+	sr := NewStringReadCloser(data)
+	c := NewCsvUtil(sr).Comma('|').
+		TrailingComma(true).
+		FieldsPerRecord(-1).
+		CustomBool([]string{"Y"}, []string{"N"}).
+		Checksum(sha256.New())
+
+	// Start test
+	p := &person{}
+	for c.SetData(p) == nil {
+	}
+	exp := sha256.Sum256([]byte(data))
+	assert.Equal(t, exp[:], c.Sum())
+	assert.Equal(t, '|', c.csvr.Comma)
+	assert.Equal(t, []byte(nil), NewCsvUtil(nil).Sum())
+}
+
+func Test_FromString(t *testing.T) {
+	// Prepare test
+	exp := person{Name: "Tony", Age: 23, Balance: 123.456, LowBalance: true}
+
+	// Start test
+	for _, c := range []*Reader{FromString(testCsvLines[0]), FromBytes([]byte(testCsvLines[0]))} {
+		p := &person{}
+		c.Comma('|').CustomBool([]string{"Y"}, []string{"N"})
+		assert.NotError(t, c.SetData(p))
+		assert.Equal(t, exp, *p)
+		assert.NotError(t, c.Close())
+	}
+}
+
+type closeCounter struct {
+	io.Reader
+	closed int
+}
+
+func (c *closeCounter) Close() error {
+	c.closed++
+	return nil
+}
+
+func Test_OwnsSource(t *testing.T) {
+	// Prepare test
+	src := &closeCounter{Reader: strings.NewReader(testCsvLines[0])}
+
+	// Start test
+	assert.NotError(t, NewCsvUtil(src).Close())
+	assert.Equal(t, 1, src.closed)
+
+	assert.NotError(t, NewCsvUtil(src).OwnsSource(false).Close())
+	assert.Equal(t, 1, src.closed)
+
+	assert.NotError(t, NewCsvUtil(strings.NewReader("a,b")).Close())
+}
+
+func Test_NoMappableFields(t *testing.T) {
+	// Prepare test
+	type hidden struct {
+		name    string
+		Skipped string `csv:"-"`
+	}
+	c := FromString("Tony,x")
+
+	// Start test
+	err := c.SetData(&hidden{})
+	assert.Equal(t, true, errors.Is(err, ErrNoMappableFields))
+	assert.Equal(t, "no mappable fields in csvutil.hidden: name (unexported), Skipped (tagged skip)", err.Error())
+	assert.Equal(t, 0, c.row)
+}
+
+func Test_CacheHeaders(t *testing.T) {
+	// Prepare test
+	type uncached struct {
+		Name string
+		Age  int
+	}
+	c := FromString("Tony,23").CacheHeaders(false)
+
+	// Start test
+	got := &uncached{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, uncached{"Tony", 23}, *got)
+	_, ok := hCache["csvutil.uncached"]
+	assert.Equal(t, false, ok)
+
+	// Header read from the file is used by each Reader.
+	for _, data := range []string{"Name,Age\nTony,23", "Age,Name\n23,Tony"} {
+		got = &uncached{}
+		assert.NotError(t, FromString(data).HeaderFromFirstRow().SetData(got))
+		assert.Equal(t, uncached{"Tony", 23}, *got)
+	}
+}
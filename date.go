@@ -0,0 +1,65 @@
+package csvutil
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date is calendar date without time of day and time zone. Unlike
+// time.Time it can't shift to the previous or next day when converted
+// between time zones. It's decoded from ISO 8601 dates: 2023-05-04,
+// 2023-W18-4 or 2023-124, and written as 2023-05-04. The zero Date is
+// an empty value.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t in its location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// In returns midnight of the date in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// IsZero returns true for the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// Before returns true if d is before other.
+func (d Date) Before(other Date) bool {
+	return d.In(time.UTC).Before(other.In(time.UTC))
+}
+
+// String returns the date in 2006-01-02 format.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Date) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Date) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*d = Date{}
+		return nil
+	}
+	t, ok := parseISODate(string(b))
+	if !ok {
+		return fmt.Errorf("invalid date %s", b)
+	}
+	*d = DateOf(t)
+	return nil
+}
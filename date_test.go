@@ -0,0 +1,59 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+type invoice struct {
+	Number string
+	Issued Date
+	Due    Date
+}
+
+func Test_Date(t *testing.T) {
+	// Prepare test
+	c := FromString("F/1,2023-05-04,2023-W20-1\nF/2,2023-124,\nF/3,04.05.2023,\n")
+
+	// Start test
+	got := &invoice{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, invoice{"F/1", Date{2023, time.May, 4}, Date{2023, time.May, 15}}, *got)
+	assert.Equal(t, "F/1,2023-05-04,2023-05-15", ToCsv(got, ",", "true", "false"))
+
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, invoice{"F/2", Date{2023, time.May, 4}, Date{}}, *got)
+	assert.Equal(t, "F/2,2023-05-04,", ToCsv(got, ",", "true", "false"))
+
+	err := c.SetData(got)
+	assert.Equal(t, "invalid date 04.05.2023", err.Error())
+}
+
+func Test_DateOf(t *testing.T) {
+	// Prepare test
+	warsaw := time.FixedZone("CEST", 2*60*60)
+	tm := time.Date(2023, 5, 4, 0, 30, 0, 0, warsaw)
+
+	// Start test
+	d := DateOf(tm)
+	assert.Equal(t, Date{2023, time.May, 4}, d)
+	assert.Equal(t, Date{2023, time.May, 3}, DateOf(tm.UTC()))
+	assert.Equal(t, tm.Add(-30*time.Minute), d.In(warsaw))
+	assert.Equal(t, true, DateOf(tm.UTC()).Before(d))
+	assert.Equal(t, true, Date{}.IsZero())
+}
+
+func Test_ToRecordMarshalTextError(t *testing.T) {
+	// Prepare test
+	type stamp struct {
+		At time.Time
+	}
+	v := &stamp{At: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	// Start test
+	_, err := toRecord(v, "true", "false", false, false, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, true, strings.HasPrefix(err.Error(), "Wasn't able to get value for field: At: "))
+}
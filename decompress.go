@@ -0,0 +1,128 @@
+package csvutil
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"io"
+	"sync"
+)
+
+// Decompressor returns reader decompressing the passed stream.
+type Decompressor func(r io.Reader) (io.ReadCloser, error)
+
+// Magic bytes of supported compression formats.
+var (
+	GzipMagic  = []byte{0x1f, 0x8b}
+	Bzip2Magic = []byte{'B', 'Z', 'h'}
+	ZstdMagic  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// ErrNoDecompressor is returned when stream is compressed with known format
+// but no decompressor has been registered for it.
+var ErrNoDecompressor = errors.New("no decompressor registered for compressed input")
+
+// decompressor pairs magic bytes with decompressor.
+type decompressor struct {
+	magic []byte
+	fn    Decompressor
+	check func(br *bufio.Reader) bool // Checks the rest of the header, may be nil
+}
+
+// Registered decompressors. Zstandard has no decompressor by default to
+// keep the package free of external dependencies.
+var (
+	decompressorsMu sync.RWMutex
+	decompressors   = []decompressor{
+		{GzipMagic, func(r io.Reader) (io.ReadCloser, error) { return gzip.NewReader(r) }, nil},
+		{Bzip2Magic, func(r io.Reader) (io.ReadCloser, error) { return io.NopCloser(bzip2.NewReader(r)), nil }, bzip2Header},
+		{ZstdMagic, nil, nil},
+	}
+)
+
+// Signatures of bzip2 compressed block and of the end of stream, one of them
+// follows the block size digit.
+var (
+	bzip2Block = []byte{0x31, 0x41, 0x59, 0x26, 0x53, 0x59}
+	bzip2End   = []byte{0x17, 0x72, 0x45, 0x38, 0x50, 0x90}
+)
+
+// bzip2Header returns true if the stream starting with Bzip2Magic continues
+// with block size and block signature. Plain text may start with "BZh".
+func bzip2Header(br *bufio.Reader) bool {
+	h, _ := br.Peek(len(Bzip2Magic) + 1 + len(bzip2Block))
+	if len(h) < len(Bzip2Magic)+1+len(bzip2Block) {
+		return false
+	}
+	size, sig := h[len(Bzip2Magic)], h[len(Bzip2Magic)+1:]
+	return size >= '1' && size <= '9' && (bytes.Equal(sig, bzip2Block) || bytes.Equal(sig, bzip2End))
+}
+
+// RegisterDecompressor registers decompressor for streams starting with magic bytes.
+// Registering magic bytes which are already known replaces the decompressor.
+//
+// Example:
+//
+//	// Using github.com/klauspost/compress/zstd.
+//	csvutil.RegisterDecompressor(csvutil.ZstdMagic, func(r io.Reader) (io.ReadCloser, error) {
+//		d, err := zstd.NewReader(r)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return d.IOReadCloser(), nil
+//	})
+func RegisterDecompressor(magic []byte, fn Decompressor) {
+	decompressorsMu.Lock()
+	defer decompressorsMu.Unlock()
+	for i, d := range decompressors {
+		if bytes.Equal(d.magic, magic) {
+			decompressors[i].fn = fn
+			return
+		}
+	}
+	decompressors = append(decompressors, decompressor{magic: magic, fn: fn})
+}
+
+// Decompress detects compression of the stream by its magic bytes and returns
+// reader with decompressed data. Uncompressed streams are returned unchanged.
+// Closing returned reader closes rc.
+func Decompress(rc io.ReadCloser) (io.ReadCloser, error) {
+	br := bufio.NewReader(rc)
+
+	decompressorsMu.RLock()
+	registered := append([]decompressor(nil), decompressors...)
+	decompressorsMu.RUnlock()
+
+	for _, d := range registered {
+		magic, _ := br.Peek(len(d.magic))
+		if !bytes.Equal(magic, d.magic) || (d.check != nil && !d.check(br)) {
+			continue
+		}
+		if d.fn == nil {
+			return nil, ErrNoDecompressor
+		}
+		dr, err := d.fn(br)
+		if err != nil {
+			return nil, err
+		}
+		return &decompressReader{ReadCloser: dr, src: rc}, nil
+	}
+
+	return &decompressReader{ReadCloser: io.NopCloser(br), src: rc}, nil
+}
+
+// decompressReader closes both decompressor and the source stream.
+type decompressReader struct {
+	io.ReadCloser
+	src io.Closer
+}
+
+func (d *decompressReader) Close() error {
+	err := d.ReadCloser.Close()
+	if serr := d.src.Close(); err == nil {
+		err = serr
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+package csvutil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+func Test_DecompressGzip(t *testing.T) {
+	// Prepare test
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	gw.Write([]byte(strings.Join(testCsvLines, "\n")))
+	gw.Close()
+
+	// Start test
+	rc, err := Decompress(io.NopCloser(&buf))
+	assert.NotError(t, err)
+
+	c := NewCsvUtil(rc).Comma('|').TrailingComma(true).FieldsPerRecord(-1).CustomBool([]string{"Y"}, []string{"N"})
+	p := &person{}
+	err = c.SetData(p)
+	assert.NotError(t, err)
+	assert.Equal(t, "Tony", p.Name)
+	assert.NotError(t, rc.Close())
+}
+
+func Test_DecompressPlain(t *testing.T) {
+	// Start test
+	rc, err := Decompress(NewStringReadCloser("a,b"))
+	assert.NotError(t, err)
+
+	data, err := io.ReadAll(rc)
+	assert.NotError(t, err)
+	assert.Equal(t, "a,b", string(data))
+}
+
+func Test_DecompressZstdNotRegistered(t *testing.T) {
+	// Start test
+	_, err := Decompress(NewStringReadCloser(string(ZstdMagic) + "data"))
+	assert.Equal(t, ErrNoDecompressor, err)
+}
+
+func Test_DecompressBzip2Header(t *testing.T) {
+	// Prepare test
+	// Empty stream compressed with bzip2 -9.
+	empty := "BZh9\x17\x72\x45\x38\x50\x90\x00\x00\x00\x00"
+
+	// Start test
+	rc, err := Decompress(NewStringReadCloser(empty))
+	assert.NotError(t, err)
+	data, err := io.ReadAll(rc)
+	assert.NotError(t, err)
+	assert.Equal(t, "", string(data))
+
+	rc, err = Decompress(NewStringReadCloser("BZh,name\n1,Tony\n"))
+	assert.NotError(t, err)
+	data, err = io.ReadAll(rc)
+	assert.NotError(t, err)
+	assert.Equal(t, "BZh,name\n1,Tony\n", string(data))
+}
+
+func Test_RegisterDecompressor(t *testing.T) {
+	// Prepare test
+	defer func(saved []decompressor) {
+		decompressorsMu.Lock()
+		decompressors = saved
+		decompressorsMu.Unlock()
+	}(append([]decompressor(nil), decompressors...))
+	magic := []byte("UPPER")
+	RegisterDecompressor(magic, func(r io.Reader) (io.ReadCloser, error) {
+		data, err := io.ReadAll(r)
+		return io.NopCloser(strings.NewReader(strings.ToLower(string(data[len(magic):])))), err
+	})
+
+	// Start test
+	rc, err := Decompress(NewStringReadCloser("UPPERA,B"))
+	assert.NotError(t, err)
+
+	data, err := io.ReadAll(rc)
+	assert.NotError(t, err)
+	assert.Equal(t, "a,b", string(data))
+}
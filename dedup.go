@@ -0,0 +1,88 @@
+package csvutil
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+)
+
+// DedupBy makes Export skip rows with the same values of columns as an
+// earlier row, all columns are compared when none are given. Only the
+// previous row is remembered unless DedupMemory is set, which is enough
+// for duplicates following each other.
+//
+// Example:
+//
+//	csvutil.NewExport(events, d).DedupBy("id").DedupMemory(100000).WriteTo(f)
+func (e *Export) DedupBy(columns ...string) *Export {
+	e.dedup = true
+	e.dedupCols = columns
+	return e
+}
+
+// DedupMemory sets how many distinct rows DedupBy remembers, the oldest
+// is forgotten first (default: 1). Rows are remembered by hashes of their
+// values so memory used doesn't depend on their size.
+func (e *Export) DedupMemory(n int) *Export {
+	e.dedupMem = n
+	return e
+}
+
+// deduper finds rows which have been seen recently.
+type deduper struct {
+	idx   []int // Compared columns, nil for all
+	max   int   // Maximum number of remembered rows
+	seen  map[[sha256.Size]byte]struct{}
+	order [][sha256.Size]byte // Remembered rows, the oldest first
+}
+
+// newDeduper returns deduper comparing columns of records with names
+// remembering max rows.
+func newDeduper(names, columns []string, max int) (*deduper, error) {
+	d := &deduper{max: max, seen: make(map[[sha256.Size]byte]struct{})}
+	if d.max < 1 {
+		d.max = 1
+	}
+	for _, col := range columns {
+		i := indexOf(names, col)
+		if i < 0 {
+			return nil, fmt.Errorf("export: dedup column %s not found", col)
+		}
+		d.idx = append(d.idx, i)
+	}
+	return d, nil
+}
+
+// duplicate returns true if the record has been seen, otherwise it
+// remembers it.
+func (d *deduper) duplicate(record []string) bool {
+	h := sha256.New()
+	add := func(value string) {
+		h.Write([]byte(strconv.Itoa(len(value)) + ":" + value))
+	}
+	if d.idx == nil {
+		for _, value := range record {
+			add(value)
+		}
+	} else {
+		for _, i := range d.idx {
+			if i < len(record) {
+				add(record[i])
+			}
+		}
+	}
+
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+	if _, ok := d.seen[key]; ok {
+		return true
+	}
+
+	if len(d.order) == d.max {
+		delete(d.seen, d.order[0])
+		d.order = d.order[1:]
+	}
+	d.seen[key] = struct{}{}
+	d.order = append(d.order, key)
+	return false
+}
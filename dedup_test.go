@@ -0,0 +1,46 @@
+package csvutil
+
+import (
+	"bytes"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_ExportDedupBy(t *testing.T) {
+	// Prepare test
+	rows := []person2{{"Tony", 1}, {"Tony", 1}, {"John", 2}, {"Tony", 1}, {"Tony", 3}}
+	var buf bytes.Buffer
+
+	// Start test
+	_, err := NewExport(rows, Dialect{}).DedupBy().WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "Tony,1\nJohn,2\nTony,1\nTony,3\n", buf.String())
+
+	buf.Reset()
+	_, err = NewExport(rows, Dialect{}).DedupBy().DedupMemory(10).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "Tony,1\nJohn,2\nTony,3\n", buf.String())
+
+	buf.Reset()
+	_, err = NewExport(rows, Dialect{}).DedupBy("Name").DedupMemory(10).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "Tony,1\nJohn,2\n", buf.String())
+
+	_, err = NewExport(rows, Dialect{}).DedupBy("Age").WriteTo(&buf)
+	assert.NotNil(t, err)
+}
+
+func Test_deduperMemory(t *testing.T) {
+	// Prepare test
+	d, err := newDeduper(nil, nil, 2)
+	assert.NotError(t, err)
+
+	// Start test
+	assert.Equal(t, false, d.duplicate([]string{"a"}))
+	assert.Equal(t, false, d.duplicate([]string{"b"}))
+	assert.Equal(t, true, d.duplicate([]string{"a"}))
+	assert.Equal(t, false, d.duplicate([]string{"c"}))
+	assert.Equal(t, false, d.duplicate([]string{"a"}))
+	assert.Equal(t, false, d.duplicate([]string{"ab"}))
+	assert.Equal(t, false, d.duplicate([]string{"a", "b"}))
+}
@@ -0,0 +1,93 @@
+package csvutil
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// TypeDate is the type of columns holding dates reported by DetectTypes.
+// Schema does not validate dates, such columns are TypeString there.
+const TypeDate = "date"
+
+// MaxConflicts is the maximum number of conflicting values in Candidate.
+var MaxConflicts = 3
+
+// Date layouts recognized by DetectTypes.
+var dateLayouts = []string{"2006-01-02", "2006/01/02", "01/02/2006", "02.01.2006", time.RFC3339}
+
+// Candidate is a type the column values may have.
+type Candidate struct {
+	Type       string   // One of Type* constants
+	Confidence float64  // Share of non empty values of the type, from 0 to 1
+	Conflicts  []string // Example values which are not of the type
+}
+
+// Types checked by DetectTypes from the most specific.
+var candidateTypes = []string{TypeInt, TypeBool, TypeFloat, TypeDate, TypeString}
+
+// DetectTypes returns candidate types of each column given as list of its
+// values. Candidates are ordered by confidence and then by how specific
+// they are, types no value has are left out. Empty values are ignored.
+// Columns with several candidates of high confidence, e.g. "01/02/2023"
+// being TypeDate or TypeString, are worth confirming with the user.
+func DetectTypes(columns [][]string) [][]Candidate {
+	result := make([][]Candidate, len(columns))
+	for i, values := range columns {
+		result[i] = detectColumn(values)
+	}
+	return result
+}
+
+// detectColumn returns candidate types of the column values.
+func detectColumn(values []string) []Candidate {
+	var candidates []Candidate
+	for _, typ := range candidateTypes {
+		c := Candidate{Type: typ}
+		total, matched := 0, 0
+		for _, v := range values {
+			if v == "" {
+				continue
+			}
+			total++
+			if isType(typ, v) {
+				matched++
+			} else if len(c.Conflicts) < MaxConflicts {
+				c.Conflicts = append(c.Conflicts, v)
+			}
+		}
+		if total == 0 {
+			return []Candidate{{Type: TypeString, Confidence: 1}}
+		}
+		if matched > 0 {
+			c.Confidence = float64(matched) / float64(total)
+			candidates = append(candidates, c)
+		}
+	}
+
+	// Stable sort keeps more specific types first.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+	return candidates
+}
+
+// isType returns true if value can be parsed as type typ.
+func isType(typ, value string) bool {
+	var err error
+	switch typ {
+	case TypeInt:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case TypeBool:
+		_, err = strconv.ParseBool(value)
+	case TypeFloat:
+		_, err = strconv.ParseFloat(value, 64)
+	case TypeDate:
+		for _, layout := range dateLayouts {
+			if _, err = time.Parse(layout, value); err == nil {
+				break
+			}
+		}
+	}
+	return err == nil
+}
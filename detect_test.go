@@ -0,0 +1,29 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_DetectTypes(t *testing.T) {
+	// Prepare test
+	columns := [][]string{
+		{"01/02/2023", "2023-02-01", ""},
+		{"1", "2", "x", "y", "z", "w"},
+		{"", ""},
+	}
+
+	// Start test
+	got := DetectTypes(columns)
+	assert.Equal(t, []Candidate{
+		{Type: TypeDate, Confidence: 1},
+		{Type: TypeString, Confidence: 1},
+	}, got[0])
+	assert.Equal(t, []Candidate{
+		{Type: TypeString, Confidence: 1},
+		{Type: TypeInt, Confidence: 2.0 / 6, Conflicts: []string{"x", "y", "z"}},
+		{Type: TypeFloat, Confidence: 2.0 / 6, Conflicts: []string{"x", "y", "z"}},
+		{Type: TypeBool, Confidence: 1.0 / 6, Conflicts: []string{"2", "x", "y"}},
+	}, got[1])
+	assert.Equal(t, []Candidate{{Type: TypeString, Confidence: 1}}, got[2])
+}
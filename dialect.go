@@ -0,0 +1,157 @@
+package csvutil
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"sort"
+	"unicode/utf8"
+)
+
+// Dialect describes CSV file format. It can be detected from a sample,
+// declared in code or loaded from JSON and applied to both reading and
+// writing side of the pipeline so they don't drift apart.
+type Dialect struct {
+	Delimiter  string   `json:"delimiter"`            // Field delimiter, ',' when empty
+	Comment    string   `json:"comment,omitempty"`    // Comment character for start of line
+	LazyQuotes bool     `json:"lazyQuotes,omitempty"` // Allow quotes in unquoted fields
+	CRLF       bool     `json:"crlf,omitempty"`       // Records are terminated with \r\n
+	Null       string   `json:"null,omitempty"`       // Value representing empty column
+	True       []string `json:"true,omitempty"`       // Values meaning true, the first is written
+	False      []string `json:"false,omitempty"`      // Values meaning false, the first is written
+}
+
+// Delimiters tried by DetectDialect.
+var detectDelimiters = []byte{',', ';', '\t', '|'}
+
+// DetectDialect guesses the dialect from the beginning of the CSV file.
+// The delimiter is the candidate which splits sample lines into the same,
+// biggest number of fields.
+func DetectDialect(sample []byte) Dialect {
+	d := Dialect{Delimiter: ",", CRLF: bytes.Contains(sample, []byte("\r\n"))}
+
+	lines := bytes.Split(sample, []byte("\n"))
+	// The last line may be incomplete.
+	if len(lines) > 1 {
+		lines = lines[:len(lines)-1]
+	}
+
+	best := 0
+	for _, delim := range detectDelimiters {
+		fields := -1
+		for _, line := range lines {
+			n := countOutsideQuotes(line, delim)
+			if fields == -1 {
+				fields = n
+			} else if n != fields {
+				fields = 0
+				break
+			}
+		}
+		if fields > best {
+			best = fields
+			d.Delimiter = string(delim)
+		}
+	}
+
+	return d
+}
+
+// sniffDialect detects the dialect from the beginning of r. The returned
+// reader must be used instead of r as the beginning has been read.
+func sniffDialect(r io.Reader) (Dialect, io.Reader, error) {
+	br := bufio.NewReaderSize(r, 64*1024)
+	sample, err := br.Peek(64 * 1024)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return Dialect{}, nil, err
+	}
+	return DetectDialect(sample), br, nil
+}
+
+// countOutsideQuotes counts occurrences of c outside of quoted fields.
+func countOutsideQuotes(line []byte, c byte) int {
+	n := 0
+	quoted := false
+	for _, b := range line {
+		switch b {
+		case '"':
+			quoted = !quoted
+		case c:
+			if !quoted {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// comma returns field delimiter rune.
+func (d Dialect) comma() rune {
+	if d.Delimiter == "" {
+		return ','
+	}
+	r, _ := utf8.DecodeRuneInString(d.Delimiter)
+	return r
+}
+
+// boolValues returns strings written for true and false.
+func (d Dialect) boolValues() (string, string) {
+	t, f := "true", "false"
+	if len(d.True) > 0 {
+		t = d.True[0]
+	}
+	if len(d.False) > 0 {
+		f = d.False[0]
+	}
+	return t, f
+}
+
+// Dialect configures Reader to read files in dialect d.
+func (r *Reader) Dialect(d Dialect) *Reader {
+	r.Comma(d.comma()).LazyQuotes(d.LazyQuotes).Null(d.Null).CustomBool(d.True, d.False)
+	if d.Comment != "" {
+		c, _ := utf8.DecodeRuneInString(d.Comment)
+		r.Comment(c)
+	}
+	return r
+}
+
+// ToCsv returns CSV line for the struct v in dialect d. See ToCsv function.
+func (d Dialect) ToCsv(v interface{}) string {
+	t, f := d.boolValues()
+	return ToCsv(v, string(d.comma()), t, f)
+}
+
+// NewWriter returns csv.Writer writing to w in dialect d.
+func (d Dialect) NewWriter(w io.Writer) *csv.Writer {
+	cw := csv.NewWriter(w)
+	cw.Comma = d.comma()
+	cw.UseCRLF = d.CRLF
+	return cw
+}
+
+// dialect returns the dialect Reader is configured with.
+func (r *Reader) dialect() Dialect {
+	d := Dialect{
+		Delimiter:  string(r.csvr.Comma),
+		LazyQuotes: r.csvr.LazyQuotes,
+		Null:       r.null,
+		True:       sortedKeys(r.customTBool),
+		False:      sortedKeys(r.customFBool),
+	}
+	if r.csvr.Comment != 0 {
+		d.Comment = string(r.csvr.Comment)
+	}
+	return d
+}
+
+// sortedKeys returns sorted keys of the set m or nil if it's empty.
+func sortedKeys(m map[string]struct{}) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
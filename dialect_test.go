@@ -0,0 +1,54 @@
+package csvutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_DetectDialect(t *testing.T) {
+	assert.Equal(t, Dialect{Delimiter: ";"}, DetectDialect([]byte("a;b;c\n1;\"2,5\";3\n4;5;6")))
+	assert.Equal(t, Dialect{Delimiter: "\t", CRLF: true}, DetectDialect([]byte("a\tb\r\n1,5\t2\r\n")))
+	assert.Equal(t, Dialect{Delimiter: "|"}, DetectDialect([]byte("Tony|23|123.456|Y\nJohn|34|234.567|N\nJo")))
+	assert.Equal(t, Dialect{Delimiter: ","}, DetectDialect([]byte("single")))
+}
+
+func Test_DialectJSON(t *testing.T) {
+	// Prepare test
+	d := Dialect{Delimiter: "|", Null: "NULL", True: []string{"Y"}, False: []string{"N"}}
+
+	// Start test
+	data, err := json.Marshal(d)
+	assert.NotError(t, err)
+	assert.Equal(t, `{"delimiter":"|","null":"NULL","true":["Y"],"false":["N"]}`, string(data))
+
+	var got Dialect
+	assert.NotError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, d, got)
+}
+
+func Test_ReaderDialect(t *testing.T) {
+	// Prepare test
+	d := Dialect{Delimiter: "|", Comment: "#", Null: "NULL", True: []string{"Y"}, False: []string{"N"}}
+	sr := NewStringReadCloser("# people\nTony|NULL|123.456|Y")
+	c := NewCsvUtil(sr).Dialect(d)
+
+	// Start test
+	p := &person{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person{Name: "Tony", Age: 0, Balance: 123.456, LowBalance: true}, *p)
+
+	assert.Equal(t, "Tony|0|123.456|Y", d.ToCsv(p))
+}
+
+func Test_DialectNewWriter(t *testing.T) {
+	// Prepare test
+	var buf bytes.Buffer
+	w := Dialect{Delimiter: ";", CRLF: true}.NewWriter(&buf)
+
+	// Start test
+	w.Write([]string{"a", "b;c"})
+	w.Flush()
+	assert.Equal(t, "a;\"b;c\"\r\n", buf.String())
+}
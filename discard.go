@@ -0,0 +1,64 @@
+package csvutil
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// Discard makes SetData decode and validate records without populating
+// the structure passed to it (default: false). Use it to check files or
+// measure decoding throughput.
+func (r *Reader) Discard(b bool) *Reader {
+	r.discard = b
+	return r
+}
+
+// Count reads all remaining records and returns how many of them are valid
+// and how many are not. When v is nil records are only parsed as CSV,
+// otherwise they are decoded and validated as for the structure v in
+// Discard mode. The header record is not counted. Returned error is not
+// nil only when reading fails.
+//
+// Example:
+//
+//	valid, invalid, err := c.Count(&person{})
+func (r *Reader) Count(v interface{}) (valid, invalid int, err error) {
+	if v != nil {
+		defer r.Discard(r.discard)
+		r.Discard(true)
+	} else if r.headerRow && r.columns == nil {
+		if err = r.readHeader(); err != nil {
+			if err == io.EOF {
+				return 0, 0, nil
+			}
+			return 0, 0, err
+		}
+	}
+
+	for {
+		if v == nil {
+			_, err = r.read()
+		} else {
+			err = r.SetData(v)
+		}
+		switch err.(type) {
+		case nil:
+			valid++
+			continue
+		case *csv.ParseError:
+			// CSV reader continues with the next record.
+			invalid++
+			continue
+		}
+		if err == io.EOF {
+			return valid, invalid, nil
+		}
+		if err == ErrBatchBoundary {
+			continue
+		}
+		if v == nil || r.csvLine == nil {
+			return valid, invalid, err
+		}
+		invalid++
+	}
+}
@@ -0,0 +1,58 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type counted struct {
+	Name string `validate:"minlen=2"`
+	Age  int
+}
+
+func Test_Discard(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,23\nJohn,x\n").Discard(true)
+
+	// Start test
+	got := &counted{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, counted{}, *got)
+	assert.NotNil(t, c.SetData(got))
+}
+
+func Test_Count(t *testing.T) {
+	// Prepare test
+	data := "Tony,23\nJohn,x\nA,5\nAnna,7,1\nMark,9\n"
+
+	// Start test
+	valid, invalid, err := FromString(data).Count(&counted{})
+	assert.NotError(t, err)
+	assert.Equal(t, 2, valid)
+	assert.Equal(t, 3, invalid)
+
+	valid, invalid, err = FromString(data).Count(nil)
+	assert.NotError(t, err)
+	assert.Equal(t, 4, valid)
+	assert.Equal(t, 1, invalid)
+}
+
+func Test_CountHeader(t *testing.T) {
+	// Prepare test
+	data := "Name,Age\nTony,23\nJohn,x\n"
+
+	// Start test
+	valid, invalid, err := FromString(data).HeaderFromFirstRow().Count(nil)
+	assert.NotError(t, err)
+	assert.Equal(t, 2, valid)
+	assert.Equal(t, 0, invalid)
+
+	valid, invalid, err = FromString(data).HeaderFromFirstRow().Count(&counted{})
+	assert.NotError(t, err)
+	assert.Equal(t, 1, valid)
+	assert.Equal(t, 1, invalid)
+
+	valid, _, err = FromString("").HeaderFromFirstRow().Count(nil)
+	assert.NotError(t, err)
+	assert.Equal(t, 0, valid)
+}
@@ -0,0 +1,29 @@
+package csvutil
+
+// fieldFailed returns error of the field f replacing its message with the
+// one from errmsg tag option when present.
+func (r *Reader) fieldFailed(f *sField, value string, err error) error {
+	msg, ok := f.opts.get("errmsg")
+	if !ok {
+		return err
+	}
+	if fe, ok := err.(*FieldError); ok {
+		fe.Err = &messageError{msg: msg, err: fe.Err}
+		return fe
+	}
+	return r.fieldError(f, value, &messageError{msg: msg, err: err})
+}
+
+// messageError is error with the message from errmsg tag option.
+type messageError struct {
+	msg string
+	err error // The original error
+}
+
+func (e *messageError) Error() string {
+	return e.msg
+}
+
+func (e *messageError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,39 @@
+package csvutil
+
+import (
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type upload struct {
+	Name string `csv:"name,errmsg=name must be 2 to 5 letters, please fix it" validate:"minlen=2,maxlen=5"`
+	Age  int    `csv:"age,errmsg=age must be a whole number"`
+}
+
+func Test_ErrMsg(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,23\nTony,x\nT,23")
+
+	// Start test
+	v := &upload{}
+	assert.NotError(t, c.SetData(v))
+
+	err := c.SetData(v)
+	assert.Equal(t, "line 2, column age: age must be a whole number", err.Error())
+	var ne *strconv.NumError
+	assert.Equal(t, true, errors.As(errors.Unwrap(err.(*FieldError).Err), &ne))
+
+	err = c.SetData(v)
+	assert.Equal(t, "line 3, column name: name must be 2 to 5 letters, please fix it", err.Error())
+	assert.Equal(t, "shorter than 2", errors.Unwrap(err.(*FieldError).Err).Error())
+}
+
+func Test_parseTagErrMsg(t *testing.T) {
+	f, _ := reflect.TypeOf(upload{}).FieldByName("Name")
+	name, opts := parseTag(f)
+	assert.Equal(t, "name", name)
+	assert.Equal(t, tagOptions{"errmsg=name must be 2 to 5 letters, please fix it"}, opts)
+}
@@ -0,0 +1,85 @@
+package csvutil
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RowError describes failure to decode a CSV record.
+type RowError struct {
+	Row int   // Number of the record starting from 1
+	Err error // The actual error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+// FieldError describes invalid CSV column value.
+//
+// Fields tagged with errmsg option report conversion and validation
+// failures with the given message instead of the one from strconv or the
+// validate rule, so it can be shown to users uploading the file. The
+// original error is available with errors.Unwrap on Err. The errmsg must be
+// the last option, the message may contain commas.
+//
+// Example:
+//
+//	type upload struct {
+//		Age int `csv:"age,errmsg=age must be a whole number" validate:"min=0"`
+//	}
+type FieldError struct {
+	Line   int    // Line in the CSV file
+	Column string // Column name
+	Value  string // The column value
+	Err    error  // The actual error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("line %d, column %s: %v", e.Line, e.Column, e.Err)
+}
+
+// fieldError returns FieldError for the structure field mapped to current record column.
+func (r *Reader) fieldError(f *sField, value string, err error) *FieldError {
+	line, _ := r.fieldPos(r.header[f.col])
+	return &FieldError{Line: line, Column: f.col, Value: value, Err: err}
+}
+
+// ColumnCountError describes record with unexpected number of columns.
+type ColumnCountError struct {
+	Line     int      // Line the record starts at
+	Expected int      // Number of columns expected
+	Got      int      // Number of columns in the record
+	Header   []string // Header of the file, nil if it has not been read
+}
+
+func (e *ColumnCountError) Error() string {
+	msg := fmt.Sprintf("line %d: expected %d columns, got %d", e.Line, e.Expected, e.Got)
+	if e.Header != nil {
+		msg += " (header: " + strings.Join(e.Header, ",") + ")"
+	}
+	return msg
+}
+
+// ErrNoMappableFields is the error NoFieldsError unwraps to.
+var ErrNoMappableFields = errors.New("no mappable fields")
+
+// NoFieldsError is returned by SetData for structures without any field
+// CSV values can be set on.
+type NoFieldsError struct {
+	Type   string         // Structure type name
+	Fields []UnboundField // Fields of the structure and why they are excluded
+}
+
+func (e *NoFieldsError) Error() string {
+	reasons := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		reasons[i] = f.Name + " (" + f.Reason + ")"
+	}
+	return fmt.Sprintf("%v in %s: %s", ErrNoMappableFields, e.Type, strings.Join(reasons, ", "))
+}
+
+func (e *NoFieldsError) Unwrap() error {
+	return ErrNoMappableFields
+}
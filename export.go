@@ -0,0 +1,376 @@
+package csvutil
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Maximum size of the buffer used by Export.
+const maxExportBuffer = 4 << 20
+
+// Export writes slice of structures as CSV file. It implements io.WriterTo
+// streaming the rows to a file or the network through a single buffer.
+//
+// Example:
+//
+//	_, err := csvutil.NewExport(people, csvutil.Dialect{}).Header(true).SizeHint(len(people) * 64).WriteTo(f)
+type Export struct {
+	rows      reflect.Value
+	d         Dialect
+	header    bool
+	style     HeaderStyle
+	styled    bool   // Convert header to style
+	size      int    // Expected size of the output
+	excel     bool   // Write Excel compatible file
+	utc       bool   // Write times in UTC
+	format    string // Compression format, empty for none
+	samples   int    // Number of rows the compression dictionary is trained on
+	footer    func(stats Stats) []string
+	tsCol     string                          // Name of the timestamp column, empty for none
+	tsFn      func(row interface{}) time.Time // Time of the row, nil for export time
+	dedup     bool                            // Skip duplicate rows
+	dedupCols []string                        // Columns compared by dedup, nil for all
+	dedupMem  int                             // Number of rows remembered by dedup
+	types     map[reflect.Type]rowType
+}
+
+// rowType describes structure type exported with TypeSwitch.
+type rowType struct {
+	code string // Value of the switch column
+	idx  int    // Index of the switch column
+}
+
+// NewExport returns Export of the rows which must be slice of structures
+// or pointers to them, written in dialect d.
+func NewExport(rows interface{}, d Dialect) *Export {
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Slice {
+		panic("Expected slice")
+	}
+	return &Export{rows: v, d: d}
+}
+
+// Header sets if the header is written before the rows (default: false).
+func (e *Export) Header(b bool) *Export {
+	e.header = b
+	return e
+}
+
+// HeaderStyle makes the header written with column names converted to
+// the naming convention of the consumer, e.g. TitleWithSpaces, without
+// renaming every tag.
+func (e *Export) HeaderStyle(s HeaderStyle) *Export {
+	e.style = s
+	e.styled = true
+	return e
+}
+
+// SizeHint sets expected size of the output in bytes. It's used to size
+// the write buffer and to grow the destination when it has Grow method,
+// like bytes.Buffer, cutting reallocations and number of writes.
+func (e *Export) SizeHint(n int) *Export {
+	e.size = n
+	return e
+}
+
+// ExcelCompatible makes Export write file Excel opens correctly when double
+// clicked: with UTF-8 byte order mark, CRLF line endings and digit strings
+// longer than 10 digits or with leading zeros, e.g. account numbers, written
+// as ="00123" text formulas so they are not turned into numbers. The
+// delimiter is semicolon when semicolon is true, as Excel expects in
+// locales using decimal comma, and comma otherwise.
+func (e *Export) ExcelCompatible(semicolon bool) *Export {
+	e.excel = true
+	e.d.CRLF = true
+	e.d.Delimiter = ","
+	if semicolon {
+		e.d.Delimiter = ";"
+	}
+	return e
+}
+
+// Compress makes Export compress the output with format, see
+// RegisterCompressor. The compressed stream is finished before WriteTo
+// returns, w is not closed. WriteTo returns number of compressed bytes.
+func (e *Export) Compress(format string) *Export {
+	e.format = format
+	return e
+}
+
+// CompressDict makes Export compress the output with dictionary trained on
+// the given number of rows sampled evenly from all rows, which makes small
+// files compress significantly better. Compressor of the format set with
+// Compress must be registered with RegisterDictCompressor.
+func (e *Export) CompressDict(samples int) *Export {
+	e.samples = samples
+	return e
+}
+
+// Footer sets function returning trailer record written after the rows,
+// e.g. with control totals partner specifications require. It gets the
+// statistics of the written values, columns are named as in the header or
+// #1, #2 and so on with TypeSwitch.
+//
+// Example:
+//
+//	e.Footer(func(s csvutil.Stats) []string {
+//		return []string{"TRAILER", strconv.Itoa(s.Rows), strconv.FormatFloat(s.Columns[2].Sum, 'f', 2, 64)}
+//	})
+func (e *Export) Footer(fn func(stats Stats) []string) *Export {
+	e.footer = fn
+	return e
+}
+
+// Timestamp adds column named column with the time of the row before the
+// other columns, e.g. for audit exports. The time is returned by fn, or is
+// the time WriteTo was called when fn is nil. Times are written in RFC 3339
+// format.
+func (e *Export) Timestamp(column string, fn func(row interface{}) time.Time) *Export {
+	e.tsCol = column
+	e.tsFn = fn
+	return e
+}
+
+// Deterministic makes the output depend only on the values of the rows so
+// the same rows give byte identical files on every machine, e.g. for golden
+// file tests. Times are written in UTC instead of their own location. Floats
+// are always written in the shortest form which reads back to the same value
+// and columns in the order of structure fields.
+func (e *Export) Deterministic(b bool) *Export {
+	e.utc = b
+	return e
+}
+
+// TypeSwitch lets rows be structures of the types in types, each with its
+// own columns, e.g. when rows is []interface{}. The column of every row is
+// set to the code its type is registered with, so the file can be read back
+// with Reader.TypeSwitch. It panics if any type lacks the column. Header
+// can't be written with mixed row types.
+func (e *Export) TypeSwitch(column string, types RowTypes) *Export {
+	e.types = make(map[reflect.Type]rowType, len(types))
+	for code, newRow := range types {
+		v := newRow()
+		names, err := Headers(v)
+		if err != nil {
+			panic(err.Error())
+		}
+		idx := indexOf(names, column)
+		if idx < 0 {
+			panic(fmt.Sprintf("csvutil: column %s not in %T", column, v))
+		}
+		e.types[reflect.TypeOf(v).Elem()] = rowType{code: code, idx: idx}
+	}
+	return e
+}
+
+// WriteTo writes all rows to w. Returns number of bytes written.
+func (e *Export) WriteTo(w io.Writer) (int64, error) {
+	// Check the configuration before anything is written.
+	if e.header && e.types != nil {
+		return 0, errors.New("export: header of mixed row types")
+	}
+	now := time.Now()
+	var names []string
+	if e.types == nil && (e.header || e.footer != nil || e.dedupCols != nil) {
+		var err error
+		if names, err = Headers(reflect.New(e.structType()).Interface()); err != nil {
+			return 0, err
+		}
+	}
+	var dedup *deduper
+	if e.dedup {
+		if e.types != nil && e.dedupCols != nil {
+			return 0, errors.New("export: dedup columns of mixed row types")
+		}
+		var err error
+		if dedup, err = newDeduper(names, e.dedupCols, e.dedupMem); err != nil {
+			return 0, err
+		}
+	}
+
+	if g, ok := w.(interface {
+		Grow(int)
+	}); ok && e.size > 0 {
+		g.Grow(e.size)
+	}
+
+	size := e.size
+	if size <= 0 {
+		size = 64 * 1024
+	}
+	if size > maxExportBuffer {
+		size = maxExportBuffer
+	}
+	cnt := &countingWriter{w: w}
+	var out io.Writer = cnt
+	var zw io.WriteCloser
+	if e.format != "" {
+		var err error
+		var samples [][]byte
+		if e.samples > 0 {
+			if samples, err = e.sample(); err != nil {
+				return 0, err
+			}
+		}
+		if zw, err = compress(cnt, e.format, samples); err != nil {
+			return 0, err
+		}
+		out = zw
+		// Release the compressor when writing fails.
+		defer func() {
+			if zw != nil {
+				zw.Close()
+			}
+		}()
+	}
+	bw := bufio.NewWriterSize(out, size)
+	cw := e.d.NewWriter(bw)
+	t, f := e.d.boolValues()
+
+	if e.excel {
+		bw.Write(utf8BOM)
+	}
+
+	if e.tsCol != "" && names != nil {
+		names = append([]string{e.tsCol}, names...)
+	}
+	if e.styled {
+		names = e.style.Apply(names)
+	}
+	if e.header {
+		if err := cw.Write(names); err != nil {
+			return cnt.n, err
+		}
+	}
+	stats := &Stats{}
+	name := func(i int) string {
+		if i < len(names) {
+			return names[i]
+		}
+		return "#" + strconv.Itoa(i+1)
+	}
+
+	for i := 0; i < e.rows.Len(); i++ {
+		row := e.rows.Index(i).Interface()
+		record, err := toRecord(row, t, f, false, e.utc, nil)
+		if err != nil {
+			return cnt.n, err
+		}
+		if e.types != nil {
+			rt, ok := e.types[reflect.Indirect(reflect.ValueOf(row)).Type()]
+			if !ok {
+				return cnt.n, fmt.Errorf("export: row type %T not registered", row)
+			}
+			record[rt.idx] = rt.code
+		}
+		if dedup != nil && dedup.duplicate(record) {
+			continue
+		}
+		if e.tsCol != "" {
+			ts := now
+			if e.tsFn != nil {
+				ts = e.tsFn(row)
+			}
+			if e.utc {
+				ts = ts.UTC()
+			}
+			record = append([]string{ts.Format(time.RFC3339Nano)}, record...)
+		}
+		if e.footer != nil {
+			stats.add(record, e.d.Null, name)
+		}
+		if e.excel {
+			for j, value := range record {
+				record[j] = excelText(value)
+			}
+		}
+		if err = cw.Write(record); err != nil {
+			return cnt.n, err
+		}
+	}
+
+	if e.footer != nil {
+		if err := cw.Write(e.footer(*stats)); err != nil {
+			return cnt.n, err
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return cnt.n, err
+	}
+	if err := bw.Flush(); err != nil {
+		return cnt.n, err
+	}
+	if zw != nil {
+		err := zw.Close()
+		zw = nil
+		return cnt.n, err
+	}
+	return cnt.n, nil
+}
+
+// excelText returns digit strings Excel would mangle as text formula.
+func excelText(value string) string {
+	if len(value) < 2 || strings.Trim(value, "0123456789") != "" {
+		return value
+	}
+	if len(value) > 10 || value[0] == '0' {
+		return `="` + value + `"`
+	}
+	return value
+}
+
+// countingWriter counts bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// sample returns CSV records of rows sampled for compression dictionary.
+func (e *Export) sample() ([][]byte, error) {
+	n := e.rows.Len()
+	step := 1
+	if e.samples < n {
+		step = n / e.samples
+	}
+	t, f := e.d.boolValues()
+	samples := [][]byte{}
+	for i := 0; i < n && len(samples) < e.samples; i += step {
+		record, err := toRecord(e.rows.Index(i).Interface(), t, f, false, e.utc, nil)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		cw := e.d.NewWriter(&buf)
+		cw.Write(record)
+		cw.Flush()
+		if err = cw.Error(); err != nil {
+			return nil, err
+		}
+		samples = append(samples, buf.Bytes())
+	}
+	return samples, nil
+}
+
+// structType returns type of the structures in rows.
+func (e *Export) structType() reflect.Type {
+	t := e.rows.Type().Elem()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
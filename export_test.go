@@ -0,0 +1,155 @@
+package csvutil
+
+import (
+	"bytes"
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_Export(t *testing.T) {
+	// Prepare test
+	people := []person{{Name: "Tony", Age: 23, Balance: 1.5, LowBalance: true}, {Name: "John", Age: 34}}
+	var buf bytes.Buffer
+
+	// Start test
+	n, err := NewExport(people, Dialect{Delimiter: ";", True: []string{"Y"}, False: []string{"N"}}).Header(true).SizeHint(1024).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "Name;Age;Balance;LowBalance\nTony;23;1.5;Y\nJohn;34;0;N\n", buf.String())
+	assert.Equal(t, int64(buf.Len()), n)
+	assert.Equal(t, true, buf.Cap() >= 1024)
+}
+
+func Test_ExportCopy(t *testing.T) {
+	// Prepare test
+	people := []*person2{{Name: "Tony", Balance: 1.5}}
+	var buf bytes.Buffer
+
+	// Start test
+	var wt io.WriterTo = NewExport(people, Dialect{CRLF: true})
+	_, err := wt.WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "Tony,1.5\r\n", buf.String())
+}
+
+func Test_ExportIDColumns(t *testing.T) {
+	// Prepare test
+	rows := []account{{Number: "00123", Zip: "01234", Owner: `Tony "T"`}}
+	var buf bytes.Buffer
+
+	// Start test
+	_, err := NewExport(rows, Dialect{}).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "00123,\"=\"\"01234\"\"\",\"Tony \"\"T\"\"\"\n", buf.String())
+
+	c := NewCsvUtil(NewStringReadCloser(buf.String()))
+	got := &account{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, rows[0], *got)
+}
+
+func Test_ExportDeterministic(t *testing.T) {
+	// Prepare test
+	type stamp struct {
+		At    time.Time
+		Value float32
+	}
+	rows := []stamp{{At: time.Date(2024, 1, 2, 15, 4, 5, 0, time.FixedZone("CET", 3600)), Value: 0.1}}
+	var buf bytes.Buffer
+
+	// Start test
+	_, err := NewExport(rows, Dialect{}).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "2024-01-02T15:04:05+01:00,0.1\n", buf.String())
+
+	buf.Reset()
+	_, err = NewExport(rows, Dialect{}).Deterministic(true).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "2024-01-02T14:04:05Z,0.1\n", buf.String())
+}
+
+type excelAccount struct {
+	Number string
+	Owner  string
+	Amount float64
+}
+
+func Test_ExportExcelCompatible(t *testing.T) {
+	// Prepare test
+	rows := []excelAccount{{Number: "00123", Owner: "Smith; John", Amount: 1.5}, {Number: "12345678901", Owner: "Ann", Amount: 2}, {Number: "42", Owner: "Jo"}}
+	var buf bytes.Buffer
+
+	// Start test
+	n, err := NewExport(rows, Dialect{}).Header(true).ExcelCompatible(true).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "\xEF\xBB\xBFNumber;Owner;Amount\r\n\"=\"\"00123\"\"\";\"Smith; John\";1.5\r\n\"=\"\"12345678901\"\"\";Ann;2\r\n42;Jo;0\r\n", buf.String())
+	assert.Equal(t, int64(buf.Len()), n)
+}
+
+func Test_ExportTypeSwitch(t *testing.T) {
+	// Prepare test
+	rows := []interface{}{&fileHeader{Origin: "ACME"}, &entry{Account: "001", Amount: 100}, fileControl{Count: 1}}
+	var buf bytes.Buffer
+
+	// Start test
+	_, err := NewExport(rows, Dialect{}).TypeSwitch("record_type", nachaTypes).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "1,ACME\n6,001,100\n9,1\n", buf.String())
+
+	var read []interface{}
+	err = FromString(buf.String()).FieldsPerRecord(-1).TypeSwitch("record_type", nachaTypes).Visit(func(v interface{}) error {
+		read = append(read, v)
+		return nil
+	})
+	assert.NotError(t, err)
+	assert.Equal(t, &entry{"6", "001", 100}, read[1])
+
+	_, err = NewExport([]interface{}{&person2{}}, Dialect{}).TypeSwitch("record_type", nachaTypes).WriteTo(&buf)
+	assert.Equal(t, "export: row type *csvutil.person2 not registered", err.Error())
+
+	_, err = NewExport(rows, Dialect{}).TypeSwitch("record_type", nachaTypes).Header(true).WriteTo(&buf)
+	assert.Equal(t, "export: header of mixed row types", err.Error())
+
+	assert.Panic(t, func() { NewExport(rows, Dialect{}).TypeSwitch("Account", RowTypes{"1": nachaTypes["1"]}) }, "csvutil: column Account not in *csvutil.fileHeader")
+}
+
+func Test_ExportFooter(t *testing.T) {
+	// Prepare test
+	people := []person{{Name: "Tony", Age: 23, Balance: 1.5}, {Name: "John", Age: 34, Balance: 2.25}}
+	var buf bytes.Buffer
+
+	// Start test
+	_, err := NewExport(people, Dialect{}).Header(true).Footer(func(s Stats) []string {
+		assert.Equal(t, "Balance", s.Columns[2].Name)
+		return []string{"TRAILER", strconv.Itoa(s.Rows), strconv.FormatFloat(s.Columns[2].Sum, 'f', 2, 64)}
+	}).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "Name,Age,Balance,LowBalance\nTony,23,1.5,false\nJohn,34,2.25,false\nTRAILER,2,3.75\n", buf.String())
+}
+
+func Test_ExportTimestamp(t *testing.T) {
+	// Prepare test
+	people := []person2{{Name: "Tony", Balance: 1.5}, {Name: "John", Balance: 2}}
+	at := time.Date(2024, 1, 2, 15, 4, 5, 0, time.FixedZone("CET", 3600))
+	var buf bytes.Buffer
+
+	// Start test
+	_, err := NewExport(people, Dialect{}).Header(true).Deterministic(true).Timestamp("exported_at", func(row interface{}) time.Time {
+		return at.Add(time.Duration(len(row.(person2).Name)) * time.Second)
+	}).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "exported_at,Name,Balance\n2024-01-02T14:04:09Z,Tony,1.5\n2024-01-02T14:04:09Z,John,2\n", buf.String())
+
+	buf.Reset()
+	start := time.Now()
+	_, err = NewExport(people, Dialect{}).Timestamp("exported_at", nil).WriteTo(&buf)
+	assert.NotError(t, err)
+	lines := strings.Split(buf.String(), "\n")
+	ts, err := time.Parse(time.RFC3339Nano, strings.Split(lines[0], ",")[0])
+	assert.NotError(t, err)
+	assert.Equal(t, false, ts.Before(start))
+	assert.Equal(t, true, strings.HasPrefix(lines[1], strings.Split(lines[0], ",")[0]+",John,"))
+}
@@ -0,0 +1,105 @@
+package csvutil
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// FingerprintPrefix starts the comment line holding mapping fingerprint.
+const FingerprintPrefix = "#csvutil-fingerprint: "
+
+// FingerprintError is returned when the fingerprint in the file does not
+// match the one expected by the reader.
+type FingerprintError struct {
+	Expected string // Fingerprint of the reader mapping
+	Got      string // Fingerprint found in the file, empty if there was none
+}
+
+func (e *FingerprintError) Error() string {
+	if e.Got == "" {
+		return "fingerprint missing, expected " + e.Expected
+	}
+	return fmt.Sprintf("fingerprint mismatch: expected %s got %s", e.Expected, e.Got)
+}
+
+// MappingFingerprint returns hash of the effective column layout of the
+// struct v written in dialect d: column names, their order, types and csv
+// tag options. Producer and consumer of the file get different
+// fingerprints when they don't agree on the layout.
+func MappingFingerprint(v interface{}, d Dialect) string {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		panic("Expected pointer to a struct")
+	}
+	fields, err := columnFields(t)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%q\n", string(d.comma()))
+	for _, f := range fields {
+		col, opts := parseTag(f)
+		fmt.Fprintf(h, "%q %s %q\n", col, f.Type, strings.Join(opts, ","))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// FingerprintLine returns the comment line with fingerprint fp which should
+// be written before the first record of the file.
+//
+// Example:
+//
+//	fp := csvutil.MappingFingerprint(&person{}, d)
+//	io.WriteString(out, csvutil.FingerprintLine(fp))
+func FingerprintLine(fp string) string {
+	return FingerprintPrefix + fp + "\n"
+}
+
+// VerifyFingerprint makes Reader check that the first line of the input is
+// the fingerprint line with fingerprint fp. The line is not passed to the
+// CSV parser. Reading fails with FingerprintError when the fingerprint is
+// missing or different. Must be called before the first record is read.
+func (r *Reader) VerifyFingerprint(fp string) *Reader {
+	r.wrap(func(src io.Reader) io.Reader {
+		return &fingerprintReader{r: bufio.NewReader(src), expected: fp}
+	})
+	return r
+}
+
+// fingerprintReader checks and drops the fingerprint line.
+type fingerprintReader struct {
+	r        *bufio.Reader
+	expected string
+	err      error // Result of the check
+	checked  bool
+}
+
+func (f *fingerprintReader) Read(p []byte) (int, error) {
+	if !f.checked {
+		f.checked = true
+		line, err := f.r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+		got := ""
+		if strings.HasPrefix(line, FingerprintPrefix) {
+			got = strings.TrimRight(line[len(FingerprintPrefix):], "\r\n")
+		}
+		if got != f.expected {
+			f.err = &FingerprintError{Expected: f.expected, Got: got}
+		}
+	}
+	if f.err != nil {
+		return 0, f.err
+	}
+	return f.r.Read(p)
+}
@@ -0,0 +1,45 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type layoutV1 struct {
+	Name string
+	Age  int
+}
+
+type layoutV2 struct {
+	Age  int
+	Name string
+}
+
+func Test_MappingFingerprint(t *testing.T) {
+	// Prepare test
+	fp := MappingFingerprint(&layoutV1{}, Dialect{})
+
+	// Start test
+	assert.Equal(t, 16, len(fp))
+	assert.Equal(t, fp, MappingFingerprint(layoutV1{}, Dialect{Delimiter: ","}))
+	assert.Equal(t, false, fp == MappingFingerprint(&layoutV2{}, Dialect{}))
+	assert.Equal(t, false, fp == MappingFingerprint(&layoutV1{}, Dialect{Delimiter: ";"}))
+}
+
+func Test_VerifyFingerprint(t *testing.T) {
+	// Prepare test
+	fp := MappingFingerprint(&layoutV1{}, Dialect{})
+	data := FingerprintLine(fp) + "Tony,23\n"
+
+	// Start test
+	got := &layoutV1{}
+	assert.NotError(t, FromString(data).VerifyFingerprint(fp).SetData(got))
+	assert.Equal(t, layoutV1{"Tony", 23}, *got)
+
+	other := MappingFingerprint(&layoutV2{}, Dialect{})
+	err := FromString(data).VerifyFingerprint(other).SetData(&layoutV2{})
+	assert.Equal(t, &FingerprintError{Expected: other, Got: fp}, err)
+
+	err = FromString("Tony,23\n").VerifyFingerprint(fp).SetData(got)
+	assert.Equal(t, "fingerprint missing, expected "+fp, err.Error())
+}
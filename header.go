@@ -0,0 +1,77 @@
+package csvutil
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Headers returns CSV column names of the struct v in the order ToCsv writes
+// the values. Returns error naming the fields when two fields have the same
+// column name as such file would be ambiguous.
+func Headers(v interface{}) ([]string, error) {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		panic("Expected pointer to a struct")
+	}
+
+	fields, err := columnFields(t)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i], _ = parseTag(f)
+	}
+	return names, nil
+}
+
+// columnFields returns fields of structure type t written as CSV columns.
+func columnFields(t reflect.Type) ([]reflect.StructField, error) {
+	var fields []reflect.StructField
+	if err := headers(t, "", &fields, make(map[string]string)); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// headers appends column fields of structure type t to fields. The names
+// maps already used column names to field names.
+func headers(t reflect.Type, prefix string, fields *[]reflect.StructField, names map[string]string) error {
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+
+		if isRowMeta(structField) {
+			continue
+		}
+
+		if structField.Anonymous {
+			et := structField.Type
+			if et.Kind() == reflect.Ptr {
+				et = et.Elem()
+			}
+			// Values of unexported embedded structs can't be read.
+			if et.Kind() != reflect.Struct || structField.PkgPath != "" {
+				continue
+			}
+			if err := headers(et, prefix+structField.Name+".", fields, names); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if skip(structField.Tag) || structField.PkgPath != "" {
+			continue
+		}
+
+		col, _ := parseTag(structField)
+		if other, ok := names[col]; ok {
+			return fmt.Errorf("duplicate column %s: fields %s and %s", col, other, prefix+structField.Name)
+		}
+		names[col] = prefix + structField.Name
+		*fields = append(*fields, structField)
+	}
+	return nil
+}
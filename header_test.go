@@ -0,0 +1,78 @@
+package csvutil
+
+import (
+	"bytes"
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_Headers(t *testing.T) {
+	// Start test
+	names, err := Headers(&person{})
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"Name", "Age", "Balance", "LowBalance"}, names)
+
+	names, err = Headers(B{})
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"Field1", "Field2", "Field3"}, names)
+
+	names, err = Headers(&secretPerson{})
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"Name", "ssn", "age"}, names)
+}
+
+func Test_HeadersPointerEmbed(t *testing.T) {
+	// Prepare test
+	type label string
+	type withPtr struct {
+		*A
+		label
+		Name string
+	}
+
+	// Start test
+	names, err := Headers(&withPtr{})
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"Field1", "Field2", "Name"}, names)
+	assert.Equal(t, 16, len(MappingFingerprint(&withPtr{}, Dialect{})))
+}
+
+func Test_HeadersDuplicate(t *testing.T) {
+	// Prepare test
+	type dup struct {
+		A
+		Name  string `csv:"name"`
+		Other string `csv:"Field2"`
+	}
+
+	// Start test
+	_, err := Headers(&dup{})
+	assert.Equal(t, errors.New("duplicate column Field2: fields A.Field2 and Other"), err)
+}
+
+func Test_HeadersRecordAligned(t *testing.T) {
+	// Prepare test
+	type Empty struct{}
+	type label string
+	type hidden struct{ Code string }
+	type mixed struct {
+		Empty
+		hidden
+		*A
+		label
+		Name string
+	}
+	v := &mixed{hidden: hidden{Code: "c"}, label: "x", Name: "Tony"}
+	var buf bytes.Buffer
+
+	// Start test
+	names, err := Headers(v)
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"Field1", "Field2", "Name"}, names)
+	assert.Equal(t, ",,Tony", ToCsv(v, ",", "T", "F"))
+
+	_, err = NewExport([]*mixed{v, {A: &A{Field1: "a", Field2: "b"}, Name: "John"}}, Dialect{}).Header(true).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "Field1,Field2,Name\n,,Tony\na,b,John\n", buf.String())
+}
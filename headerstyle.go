@@ -0,0 +1,112 @@
+package csvutil
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HeaderStyle is naming convention of CSV header columns.
+type HeaderStyle int
+
+const (
+	// SnakeCase writes lower case words joined with underscores, e.g. user_id.
+	SnakeCase HeaderStyle = iota
+	// CamelCase writes words without separators, the first one lower case, e.g. userID.
+	CamelCase
+	// TitleWithSpaces writes capitalized words separated with spaces, e.g. User ID.
+	TitleWithSpaces
+	// Upper writes upper case words joined with underscores, e.g. USER_ID.
+	Upper
+)
+
+// Apply returns column names converted to the style. Names are split into
+// words on underscores, dashes, spaces and case changes so both field names
+// and tag names can be converted. Export.HeaderStyle applies the style to
+// the header it writes.
+//
+// Example:
+//
+//	names, _ := csvutil.Headers(&person{})
+//	w.Write(csvutil.TitleWithSpaces.Apply(names))
+func (s HeaderStyle) Apply(names []string) []string {
+	out := make([]string, len(names))
+	for i, name := range names {
+		out[i] = s.convert(name)
+	}
+	return out
+}
+
+// convert returns single name converted to the style.
+func (s HeaderStyle) convert(name string) string {
+	words := splitWords(name)
+	for i, w := range words {
+		switch s {
+		case SnakeCase:
+			words[i] = strings.ToLower(w)
+		case Upper:
+			words[i] = strings.ToUpper(w)
+		case CamelCase:
+			if i == 0 {
+				words[i] = strings.ToLower(w)
+			} else {
+				words[i] = capitalize(w)
+			}
+		case TitleWithSpaces:
+			words[i] = capitalize(w)
+		}
+	}
+
+	switch s {
+	case CamelCase:
+		return strings.Join(words, "")
+	case TitleWithSpaces:
+		return strings.Join(words, " ")
+	}
+	return strings.Join(words, "_")
+}
+
+// splitWords splits name into words. Acronyms and digits are kept together,
+// e.g. "HTTPServer2ID" is split into "HTTP", "Server2" and "ID".
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && !isSeparator(runes[i]) && (i == start || !wordBoundary(runes, i)) {
+			continue
+		}
+		if i > start {
+			words = append(words, string(runes[start:i]))
+		}
+		start = i
+		if i < len(runes) && isSeparator(runes[i]) {
+			start = i + 1
+		}
+	}
+	return words
+}
+
+// wordBoundary returns true if a new word starts at runes[i].
+func wordBoundary(runes []rune, i int) bool {
+	if !unicode.IsUpper(runes[i]) {
+		return false
+	}
+	// "userID" or "HTTPServer" where S starts a new word.
+	return !unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
+
+// isSeparator returns true for runes separating words.
+func isSeparator(r rune) bool {
+	return r == '_' || r == '-' || r == ' '
+}
+
+// capitalize returns word with the first letter upper case. Acronyms are
+// kept as they are, other words are lower cased.
+func capitalize(w string) string {
+	if strings.ToUpper(w) == w {
+		return w
+	}
+	r, n := utf8.DecodeRuneInString(w)
+	return string(unicode.ToUpper(r)) + strings.ToLower(w[n:])
+}
@@ -0,0 +1,29 @@
+package csvutil
+
+import (
+	"bytes"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_HeaderStyle(t *testing.T) {
+	// Prepare test
+	names := []string{"UserID", "first_name", "HTTPServer", "zip code", "Address2"}
+
+	// Start test
+	assert.Equal(t, []string{"user_id", "first_name", "http_server", "zip_code", "address2"}, SnakeCase.Apply(names))
+	assert.Equal(t, []string{"userID", "firstName", "httpServer", "zipCode", "address2"}, CamelCase.Apply(names))
+	assert.Equal(t, []string{"User ID", "First Name", "HTTP Server", "Zip Code", "Address2"}, TitleWithSpaces.Apply(names))
+	assert.Equal(t, []string{"USER_ID", "FIRST_NAME", "HTTP_SERVER", "ZIP_CODE", "ADDRESS2"}, Upper.Apply(names))
+}
+
+func Test_ExportHeaderStyle(t *testing.T) {
+	// Prepare test
+	people := []person{{Name: "Tony", Age: 23, Balance: 1.5, LowBalance: true}}
+	var buf bytes.Buffer
+
+	// Start test
+	_, err := NewExport(people, Dialect{}).Header(true).HeaderStyle(SnakeCase).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "name,age,balance,low_balance\nTony,23,1.5,true\n", buf.String())
+}
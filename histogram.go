@@ -0,0 +1,168 @@
+package csvutil
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Bucket is the number of values of a column equal to Value, or within
+// Value range "from..to" when values are grouped.
+type Bucket struct {
+	Value string
+	Count int
+}
+
+// Distribution is frequency of column values returned by Histogram.
+type Distribution []Bucket
+
+// Histogram reads the remaining records of r and counts values of the
+// column. When buckets is zero every distinct value has its own bucket,
+// otherwise numeric values are grouped into that many buckets of equal
+// width between the smallest and the greatest value. Empty and null values
+// are counted in the bucket with empty Value which comes first. Buckets are
+// ordered by value, numerically if all values are numbers.
+//
+// The column is named by the header so HeaderFromFirstRow or NumberedHeader
+// must be set.
+//
+// Example:
+//
+//	d, err := csvutil.Histogram(csvutil.NewCsvUtil(f).HeaderFromFirstRow(), "amount", 10)
+//	err = d.WriteCSV(os.Stdout, csvutil.Dialect{})
+func Histogram(r *Reader, column string, buckets int) (Distribution, error) {
+	if err := r.needColumns(); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	idx := -1
+	for {
+		record, err := r.read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if idx < 0 {
+			if idx = indexOf(r.columns, column); idx < 0 {
+				return nil, fmt.Errorf("column %s is not in the header", column)
+			}
+		}
+
+		var value string
+		if idx < len(record) {
+			value = record[idx]
+		}
+		if r.null != "" && value == r.null {
+			value = ""
+		}
+		if buckets > 0 && value != "" {
+			if _, ok := finiteNumber(value); !ok {
+				return nil, fmt.Errorf("histogram: value %q of column %s is not a number", value, column)
+			}
+		}
+		counts[value]++
+	}
+
+	if buckets > 0 {
+		return groupCounts(counts, buckets), nil
+	}
+
+	d := make(Distribution, 0, len(counts))
+	for value, n := range counts {
+		d = append(d, Bucket{Value: value, Count: n})
+	}
+	numeric := true
+	for _, b := range d {
+		if _, ok := finiteNumber(b.Value); !ok && b.Value != "" {
+			numeric = false
+			break
+		}
+	}
+	sort.Slice(d, func(i, j int) bool {
+		if d[i].Value == "" || d[j].Value == "" {
+			return d[i].Value == ""
+		}
+		if numeric {
+			a, _ := finiteNumber(d[i].Value)
+			b, _ := finiteNumber(d[j].Value)
+			return a < b
+		}
+		return d[i].Value < d[j].Value
+	})
+	return d, nil
+}
+
+// finiteNumber parses value as number. NaN and infinities can't be ordered
+// nor grouped and are not numbers for the histogram.
+func finiteNumber(value string) (float64, bool) {
+	num, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(num) || math.IsInf(num, 0) {
+		return 0, false
+	}
+	return num, true
+}
+
+// groupCounts groups numeric values counted in counts into n buckets.
+func groupCounts(counts map[string]int, n int) Distribution {
+	nums := make(map[float64]int, len(counts))
+	min, max := math.Inf(1), math.Inf(-1)
+	for value, cnt := range counts {
+		if value == "" {
+			continue
+		}
+		num, _ := finiteNumber(value)
+		nums[num] += cnt
+		min = math.Min(min, num)
+		max = math.Max(max, num)
+	}
+
+	var d Distribution
+	if empty := counts[""]; empty > 0 {
+		d = append(d, Bucket{Count: empty})
+	}
+	if len(nums) == 0 {
+		return d
+	}
+	if max == min {
+		n = 1
+	}
+
+	width := (max - min) / float64(n)
+	grouped := make([]Bucket, n)
+	for i := range grouped {
+		hi := min + width*float64(i+1)
+		if i == n-1 {
+			hi = max
+		}
+		grouped[i].Value = formatNum(min+width*float64(i)) + ".." + formatNum(hi)
+	}
+	for num, cnt := range nums {
+		i := n - 1
+		if width > 0 && num < max {
+			i = int((num - min) / width)
+		}
+		grouped[i].Count += cnt
+	}
+	return append(d, grouped...)
+}
+
+// WriteCSV writes the distribution to w in dialect d with header row
+// value, count.
+func (dist Distribution) WriteCSV(w io.Writer, d Dialect) error {
+	cw := d.NewWriter(w)
+	if err := cw.Write([]string{"value", "count"}); err != nil {
+		return err
+	}
+	for _, b := range dist {
+		if err := cw.Write([]string{b.Value, strconv.Itoa(b.Count)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
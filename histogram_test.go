@@ -0,0 +1,48 @@
+package csvutil
+
+import (
+	"bytes"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_Histogram(t *testing.T) {
+	// Prepare test
+	data := "id,currency,amount\n1,USD,10\n2,EUR,2.5\n3,USD,NULL\n4,PLN,20\n5,USD,10\n"
+
+	// Start test
+	d, err := Histogram(FromString(data).HeaderFromFirstRow(), "currency", 0)
+	assert.NotError(t, err)
+	assert.Equal(t, Distribution{{"EUR", 1}, {"PLN", 1}, {"USD", 3}}, d)
+
+	d, err = Histogram(FromString(data).HeaderFromFirstRow().Null("NULL"), "amount", 0)
+	assert.NotError(t, err)
+	assert.Equal(t, Distribution{{"", 1}, {"2.5", 1}, {"10", 2}, {"20", 1}}, d)
+
+	d, err = Histogram(FromString(data).HeaderFromFirstRow().Null("NULL"), "amount", 2)
+	assert.NotError(t, err)
+	assert.Equal(t, Distribution{{"", 1}, {"2.5..11.25", 3}, {"11.25..20", 1}}, d)
+
+	var buf bytes.Buffer
+	assert.NotError(t, d.WriteCSV(&buf, Dialect{Delimiter: ";"}))
+	assert.Equal(t, "value;count\n;1\n2.5..11.25;3\n11.25..20;1\n", buf.String())
+
+	_, err = Histogram(FromString(data).HeaderFromFirstRow(), "currency", 2)
+	assert.Equal(t, `histogram: value "USD" of column currency is not a number`, err.Error())
+
+	_, err = Histogram(FromString(data), "currency", 0)
+	assert.Equal(t, errNoHeader, err)
+}
+
+func Test_HistogramNotFinite(t *testing.T) {
+	// Prepare test
+	data := "amount\n10\nnan\n2\ninf\n"
+
+	// Start test
+	_, err := Histogram(FromString(data).HeaderFromFirstRow(), "amount", 2)
+	assert.Equal(t, `histogram: value "nan" of column amount is not a number`, err.Error())
+
+	d, err := Histogram(FromString(data).HeaderFromFirstRow(), "amount", 0)
+	assert.NotError(t, err)
+	assert.Equal(t, Distribution{{"10", 1}, {"2", 1}, {"inf", 1}, {"nan", 1}}, d)
+}
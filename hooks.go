@@ -0,0 +1,53 @@
+package csvutil
+
+// FieldHook receives name of the structure field and the column value
+// about to be set on it and returns the value to set instead.
+type FieldHook func(field, raw string) (string, error)
+
+// RowHook receives pointer to the structure populated from the record.
+type RowHook func(v interface{}) error
+
+// BeforeField adds hook run by SetData for every field before the column
+// value is converted and validated, after whitespace, null and column
+// converters are applied. Hooks run in the order they were added, each gets
+// the value returned by the previous one. Errors are reported as
+// *FieldError.
+//
+// Example:
+//
+//	c.BeforeField(func(field, raw string) (string, error) {
+//		return strings.Map(sanitize, raw), nil
+//	})
+func (r *Reader) BeforeField(fn FieldHook) *Reader {
+	r.fieldHooks = append(r.fieldHooks, fn)
+	return r
+}
+
+// AfterRow adds hook run by SetData once the structure is populated and
+// passed all validators, e.g. to enrich it or record metrics. Errors are
+// reported as *RowError.
+func (r *Reader) AfterRow(fn RowHook) *Reader {
+	r.rowHooks = append(r.rowHooks, fn)
+	return r
+}
+
+// beforeField runs field hooks on the value of the field f.
+func (r *Reader) beforeField(f *sField, value string) (string, error) {
+	var err error
+	for _, fn := range r.fieldHooks {
+		if value, err = fn(f.name, value); err != nil {
+			return value, r.fieldError(f, value, err)
+		}
+	}
+	return value, nil
+}
+
+// afterRow runs row hooks on v.
+func (r *Reader) afterRow(v interface{}) error {
+	for _, fn := range r.rowHooks {
+		if err := fn(v); err != nil {
+			return &RowError{Row: r.row, Err: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package csvutil
+
+import (
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+type hooked struct {
+	Name string
+	Age  int
+	Note string `csv:"-"`
+}
+
+func Test_Hooks(t *testing.T) {
+	// Prepare test
+	var fields []string
+	c := FromString(" tony ,2_3\nJohn,x\n").
+		BeforeField(func(field, raw string) (string, error) {
+			fields = append(fields, field)
+			return strings.TrimSpace(raw), nil
+		}).
+		BeforeField(func(field, raw string) (string, error) {
+			if field == "Name" {
+				return strings.Title(raw), nil
+			}
+			return strings.Replace(raw, "_", "", -1), nil
+		}).
+		AfterRow(func(v interface{}) error {
+			v.(*hooked).Note = "checked"
+			return nil
+		})
+
+	// Start test
+	got := &hooked{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, hooked{"Tony", 23, "checked"}, *got)
+	assert.Equal(t, []string{"Name", "Age"}, fields)
+
+	assert.NotNil(t, c.SetData(&hooked{}))
+}
+
+func Test_HooksErrors(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,23\nJohn,34\n").
+		BeforeField(func(field, raw string) (string, error) {
+			if raw == "Tony" {
+				return raw, errors.New("banned")
+			}
+			return raw, nil
+		}).
+		AfterRow(func(v interface{}) error { return errors.New("no space left") })
+
+	// Start test
+	err := c.SetData(&hooked{})
+	assert.Equal(t, &FieldError{Line: 1, Column: "Name", Value: "Tony", Err: errors.New("banned")}, err)
+
+	err = c.SetData(&hooked{})
+	assert.Equal(t, &RowError{Row: 2, Err: errors.New("no space left")}, err)
+}
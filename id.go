@@ -0,0 +1,21 @@
+package csvutil
+
+import "strings"
+
+// quoteID quotes value of the column tagged with id option. The excel mode
+// writes it as Excel text formula.
+func quoteID(value, mode string) string {
+	value = strings.Replace(value, `"`, `""`, -1)
+	if mode == "excel" {
+		return `="` + value + `"`
+	}
+	return `"` + value + `"`
+}
+
+// fromExcelText returns value of Excel text formula ="..." or value itself.
+func fromExcelText(value string) string {
+	if len(value) >= 3 && strings.HasPrefix(value, `="`) && strings.HasSuffix(value, `"`) {
+		return strings.Replace(value[2:len(value)-1], `""`, `"`, -1)
+	}
+	return value
+}
@@ -0,0 +1,36 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type account struct {
+	Number string `csv:"number,id"`
+	Zip    string `csv:"zip,id=excel"`
+	Owner  string
+}
+
+func Test_IDColumns(t *testing.T) {
+	// Prepare test
+	a := &account{Number: "00123", Zip: "01234", Owner: "Tony"}
+
+	// Start test
+	line := ToCsv(a, ",", "T", "F")
+	assert.Equal(t, `"00123",="01234",Tony`, line)
+
+	c := NewCsvUtil(NewStringReadCloser(line)).LazyQuotes(true)
+	got := &account{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, *a, *got)
+}
+
+func Test_IDColumnsType(t *testing.T) {
+	// Prepare test
+	type badAccount struct {
+		Number int `csv:"number,id"`
+	}
+
+	// Start test
+	assert.Panic(t, func() { getFields(&badAccount{}) }, "Expected panic for id option on int field")
+}
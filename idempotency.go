@@ -0,0 +1,43 @@
+package csvutil
+
+import "fmt"
+
+// IdempotencyKey makes SetData derive stable key of every record from raw
+// values of the columns, all record values are used when no columns are
+// given. The key does not depend on the delimiter, position of the record
+// or the input name so downstream writers can use it to skip rows already
+// stored by previous, partially completed import. The key is returned by
+// Key and set in RowMeta.Key.
+//
+// Example:
+//
+//	c.IdempotencyKey("OrderID", "Line")
+func (r *Reader) IdempotencyKey(columns ...string) *Reader {
+	r.keyed = true
+	r.keyCols = columns
+	return r
+}
+
+// Key returns idempotency key of the most recent record or empty string
+// when IdempotencyKey is not set.
+func (r *Reader) Key() string {
+	return r.key
+}
+
+// recordKey returns idempotency key of the current record.
+func (r *Reader) recordKey() (string, error) {
+	values := r.csvLine
+	if len(r.keyCols) > 0 {
+		values = make([]string, len(r.keyCols))
+		for i, col := range r.keyCols {
+			idx, ok := r.header[col]
+			if !ok {
+				return "", fmt.Errorf("idempotency key column %s is not in the header", col)
+			}
+			if idx < len(r.csvLine) {
+				values[i] = r.csvLine[idx]
+			}
+		}
+	}
+	return valuesHash(values), nil
+}
@@ -0,0 +1,51 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_IdempotencyKey(t *testing.T) {
+	// Prepare test
+	c1 := NewCsvUtil(NewStringReadCloser("Tony,23\nJohn,34")).IdempotencyKey()
+	c2 := NewCsvUtil(NewStringReadCloser("John;34")).Comma(';').IdempotencyKey()
+
+	// Start test
+	p := &metaPerson{}
+	assert.NotError(t, c1.SetData(p))
+	tony := c1.Key()
+	assert.Equal(t, 64, len(tony))
+	assert.Equal(t, tony, p.Key)
+
+	assert.NotError(t, c1.SetData(p))
+	assert.NotError(t, c2.SetData(p))
+	assert.Equal(t, c1.Key(), c2.Key())
+	assert.Equal(t, true, c1.Key() != tony)
+}
+
+func Test_IdempotencyKeyColumns(t *testing.T) {
+	// Prepare test
+	c := NewCsvUtil(NewStringReadCloser("Tony,23\nTony,24")).IdempotencyKey("Name")
+
+	// Start test
+	p := &metaPerson{}
+	assert.NotError(t, c.SetData(p))
+	first := c.Key()
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, first, c.Key())
+
+	c = NewCsvUtil(NewStringReadCloser("Tony,23")).IdempotencyKey("Email")
+	assert.NotNil(t, c.SetData(p))
+}
+
+func Test_IdempotencyKeyPrefixed(t *testing.T) {
+	// Prepare test
+	c1 := NewCsvUtil(NewStringReadCloser("ab,c")).IdempotencyKey()
+	c2 := NewCsvUtil(NewStringReadCloser("a,bc")).IdempotencyKey()
+	p := &A{}
+
+	// Start test
+	assert.NotError(t, c1.SetData(p))
+	assert.NotError(t, c2.SetData(p))
+	assert.Equal(t, true, c1.Key() != c2.Key())
+}
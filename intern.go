@@ -0,0 +1,32 @@
+package csvutil
+
+// InternMaxValues is the maximum number of distinct values interned
+// per column tagged with intern option. Columns with more values are
+// probably not categorical, values not seen before the limit was reached
+// are not interned.
+var InternMaxValues = 1024
+
+// internTables holds interned values by column name.
+type internTables map[string]map[string]string
+
+// intern returns the shared copy of the column value.
+func (r *Reader) intern(col, value string) string {
+	if r.interned == nil {
+		r.interned = make(internTables)
+	}
+	table, ok := r.interned[col]
+	if !ok {
+		table = make(map[string]string)
+		r.interned[col] = table
+	}
+	if s, ok := table[value]; ok {
+		return s
+	}
+	if len(table) < InternMaxValues {
+		// The value shares memory with the whole record, copy it so the
+		// table doesn't keep records alive.
+		value = string([]byte(value))
+		table[value] = value
+	}
+	return value
+}
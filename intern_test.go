@@ -0,0 +1,35 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+	"unsafe"
+)
+
+type categorized struct {
+	ID      string
+	Country string `csv:"country,intern"`
+}
+
+func Test_Intern(t *testing.T) {
+	// Prepare test
+	var rows []categorized
+
+	// Start test
+	assert.NotError(t, FromString("1,PL\n2,US\n3,PL\n").ReadAll(&rows))
+	assert.Equal(t, "PL", rows[2].Country)
+	assert.Equal(t, unsafe.StringData(rows[0].Country), unsafe.StringData(rows[2].Country))
+	assert.Equal(t, false, unsafe.StringData(rows[0].ID) == unsafe.StringData(rows[2].ID))
+}
+
+func Test_InternMaxValues(t *testing.T) {
+	// Prepare test
+	defer func(n int) { InternMaxValues = n }(InternMaxValues)
+	InternMaxValues = 1
+	r := FromString("")
+
+	// Start test
+	r.intern("c", "a")
+	r.intern("c", "b")
+	assert.Equal(t, map[string]string{"a": "a"}, r.interned["c"])
+}
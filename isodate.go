@@ -0,0 +1,60 @@
+package csvutil
+
+import (
+	"encoding"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	isoWeekDate    = regexp.MustCompile(`^(\d{4})-W(\d{2})-([1-7])$`)
+	isoOrdinalDate = regexp.MustCompile(`^(\d{4})-(\d{3})$`)
+)
+
+// unmarshalText sets value on ut. Besides RFC 3339 accepted by time.Time,
+// its fields accept ISO 8601 dates: 2023-05-04, week date 2023-W18-4 and
+// ordinal date 2023-124, as midnight UTC.
+func unmarshalText(ut encoding.TextUnmarshaler, value string) error {
+	err := ut.UnmarshalText([]byte(value))
+	if t, ok := ut.(*time.Time); ok && err != nil {
+		if date, ok := parseISODate(value); ok {
+			*t = date
+			return nil
+		}
+	}
+	return err
+}
+
+// parseISODate parses ISO 8601 calendar, week or ordinal date.
+func parseISODate(value string) (time.Time, bool) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, true
+	}
+
+	if m := isoWeekDate.FindStringSubmatch(value); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		week, _ := strconv.Atoi(m[2])
+		day, _ := strconv.Atoi(m[3])
+		// January 4th is always in the first week.
+		jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := jan4.AddDate(0, 0, -(int(jan4.Weekday())+6)%7)
+		t := monday.AddDate(0, 0, (week-1)*7+day-1)
+		if y, w := t.ISOWeek(); y != year || w != week {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+
+	if m := isoOrdinalDate.FindStringSubmatch(value); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		day, _ := strconv.Atoi(m[2])
+		t := time.Date(year, time.January, day, 0, 0, 0, 0, time.UTC)
+		if day < 1 || t.Year() != year {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,50 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+	"time"
+)
+
+func Test_parseISODate(t *testing.T) {
+	tests := []struct {
+		value string
+		exp   string
+	}{
+		{"2023-05-04", "2023-05-04"},
+		{"2023-W18-4", "2023-05-04"},
+		{"2023-124", "2023-05-04"},
+		{"2020-W01-1", "2019-12-30"},
+		{"2020-W53-7", "2021-01-03"},
+		{"2020-366", "2020-12-31"},
+		{"2021-W53-1", ""},
+		{"2021-366", ""},
+		{"2021-000", ""},
+		{"2023-5-4", ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseISODate(tt.value)
+		if tt.exp == "" {
+			assert.Equal(t, false, ok)
+			continue
+		}
+		assert.Equal(t, true, ok)
+		assert.Equal(t, tt.exp, got.Format("2006-01-02"))
+	}
+}
+
+func Test_SetDataISODate(t *testing.T) {
+	// Prepare test
+	c := FromString("1,2023-W18-4\n2,2023-124\n3,2023-05-04T10:00:00+02:00\n4,x\n")
+
+	// Start test
+	got := &event{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, time.Date(2023, 5, 4, 0, 0, 0, 0, time.UTC), got.Timestamp)
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, time.Date(2023, 5, 4, 0, 0, 0, 0, time.UTC), got.Timestamp)
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, 10, got.Timestamp.Hour())
+	assert.NotNil(t, c.SetData(got))
+}
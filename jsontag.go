@@ -0,0 +1,41 @@
+package csvutil
+
+import (
+	"reflect"
+	"strings"
+	"sync/atomic"
+)
+
+// Not zero if json tag names are used for fields without csv tag. It's read
+// atomically as csvTag is called both with and without cacheMu held.
+var jsonFallback int32
+
+// JSONTagFallback makes fields without csv tag use the name from their json
+// tag as CSV column name, so structures annotated for JSON can be used
+// without duplicating tags. Fields tagged json:"-" are skipped. Options of
+// the json tag are ignored. It affects all Readers and ToCsv calls and
+// should be set once during program initialization.
+func JSONTagFallback(b bool) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	var v int32
+	if b {
+		v = 1
+	}
+	atomic.StoreInt32(&jsonFallback, v)
+	// Cached fields and headers may have been built with the other names.
+	fCache = nil
+	hCache = nil
+}
+
+// csvTag returns csv tag of the field or the one derived from json tag.
+func csvTag(tag reflect.StructTag) string {
+	if s, ok := tag.Lookup("csv"); ok || atomic.LoadInt32(&jsonFallback) == 0 {
+		return s
+	}
+	js := tag.Get("json")
+	if js == "-" {
+		return "-"
+	}
+	return strings.Split(js, ",")[0]
+}
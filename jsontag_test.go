@@ -0,0 +1,57 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type apiUser struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email" csv:"mail"`
+	Password string `json:"-"`
+	Note     string
+}
+
+func Test_JSONTagFallback(t *testing.T) {
+	// Prepare test
+	JSONTagFallback(true)
+	defer JSONTagFallback(false)
+
+	// Start test
+	names, err := Headers(&apiUser{})
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"id", "name", "mail", "Note"}, names)
+
+	c := FromString("Tony,7,a@b.c,x\n").Header(CsvHeader{"name": 0, "id": 1, "mail": 2, "Note": 3})
+	got := &apiUser{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, apiUser{ID: 7, Name: "Tony", Email: "a@b.c", Note: "x"}, *got)
+
+	assert.Equal(t, "1,Tony,a@b.c,x", ToCsv(&apiUser{1, "Tony", "a@b.c", "secret", "x"}, ",", "T", "F"))
+}
+
+func Test_JSONTagFallbackDisabled(t *testing.T) {
+	// Start test
+	names, err := Headers(&apiUser{})
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"ID", "Name", "mail", "Password", "Note"}, names)
+}
+
+func Test_JSONTagFallbackConcurrent(t *testing.T) {
+	// Prepare test
+	defer JSONTagFallback(false)
+	done := make(chan struct{})
+
+	// Start test
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			Headers(&apiUser{})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		JSONTagFallback(i%2 == 0)
+	}
+	<-done
+}
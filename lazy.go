@@ -0,0 +1,149 @@
+package csvutil
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// LazyRow is a CSV record whose values are converted only when accessed.
+// Use it when most rows are only checked by a few columns and decoding of
+// the whole structure would be wasted.
+//
+// Example:
+//
+//	for {
+//		row, err := c.ReadLazy()
+//		if err == io.EOF {
+//			break
+//		}
+//		if qty, err := row.Int("Qty"); err == nil && qty > 100 {
+//			// Decode the rest only for interesting rows.
+//		}
+//	}
+type LazyRow struct {
+	r      *Reader
+	header CsvHeader
+	record []string
+	quoted []bool // Quoted values, only with WhitespaceTrimUnquoted
+	line   int
+}
+
+// ReadLazy reads the next record as LazyRow. Columns are named by the header
+// set with Header, generated with NumberedHeader or read from the first
+// record when none was set.
+// Values are trimmed and null values are empty as configured on Reader.
+func (r *Reader) ReadLazy() (*LazyRow, error) {
+	if !r.customHeader {
+		if err := r.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	record, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	row := &LazyRow{r: r, header: r.header, record: record}
+	row.line, _ = r.fieldPos(0)
+	if r.whitespace == WhitespaceTrimUnquoted {
+		row.quoted = make([]bool, len(record))
+		for i := range record {
+			row.quoted[i] = r.quoted(i)
+		}
+	}
+	return row, nil
+}
+
+// Line returns line the record starts at.
+func (lr *LazyRow) Line() int {
+	return lr.line
+}
+
+// Record returns raw values of the record.
+func (lr *LazyRow) Record() []string {
+	return lr.record
+}
+
+// String returns value of the column.
+func (lr *LazyRow) String(column string) (string, error) {
+	return lr.value(column)
+}
+
+// Int returns value of the column as integer.
+func (lr *LazyRow) Int(column string) (int64, error) {
+	var i int64
+	return i, lr.convert(column, &i)
+}
+
+// Float returns value of the column as float.
+func (lr *LazyRow) Float(column string) (float64, error) {
+	var f float64
+	return f, lr.convert(column, &f)
+}
+
+// Bool returns value of the column as bool. Custom bool values set on
+// Reader are honoured.
+func (lr *LazyRow) Bool(column string) (bool, error) {
+	var b bool
+	return b, lr.convert(column, &b)
+}
+
+// Decode sets values of the record on fields of structure v. Unlike SetData
+// it only converts the values, tag options, setters and validation rules
+// are not applied.
+func (lr *LazyRow) Decode(v interface{}) error {
+	fields, _ := getFields(v)
+	value := reflect.ValueOf(v).Elem()
+	for _, f := range fields {
+		s, err := lr.value(f.col)
+		if err != nil {
+			return err
+		}
+		if err = lr.r.set(value.FieldByName(f.name), f.name, s); err != nil {
+			return &FieldError{Line: lr.line, Column: f.col, Value: s, Err: err}
+		}
+	}
+	return nil
+}
+
+// convert sets value of the column on pointer v.
+func (lr *LazyRow) convert(column string, v interface{}) error {
+	s, err := lr.value(column)
+	if err != nil {
+		return err
+	}
+	if err = lr.r.set(reflect.ValueOf(v).Elem(), column, s); err != nil {
+		return &FieldError{Line: lr.line, Column: column, Value: s, Err: err}
+	}
+	return nil
+}
+
+// value returns the column value with whitespace, trim and null settings
+// of the Reader applied.
+func (lr *LazyRow) value(column string) (string, error) {
+	i, ok := lr.header[column]
+	if !ok {
+		return "", fmt.Errorf("column %s is not in the header", column)
+	}
+	if i >= len(lr.record) {
+		return "", nil
+	}
+
+	value := lr.record[i]
+	switch lr.r.whitespace {
+	case WhitespaceTrimUnquoted:
+		if !lr.quoted[i] {
+			value = strings.TrimSpace(value)
+		}
+	case WhitespaceTrimAll:
+		value = strings.TrimSpace(value)
+	}
+	if lr.r.trim != "" {
+		value = strings.Trim(value, lr.r.trim)
+	}
+	if lr.r.null != "" && value == lr.r.null {
+		value = ""
+	}
+	return value, nil
+}
@@ -0,0 +1,56 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"testing"
+)
+
+func Test_ReadLazy(t *testing.T) {
+	// Prepare test
+	c := FromString("Name,Age,Balance,LowBalance\nTony,23,1.5,Y\nJohn,x,NULL,N\n").Null("NULL").CustomBool([]string{"Y"}, []string{"N"})
+
+	// Start test
+	row, err := c.ReadLazy()
+	assert.NotError(t, err)
+	assert.Equal(t, 2, row.Line())
+	name, err := row.String("Name")
+	assert.NotError(t, err)
+	assert.Equal(t, "Tony", name)
+	age, err := row.Int("Age")
+	assert.NotError(t, err)
+	assert.Equal(t, int64(23), age)
+	low, err := row.Bool("LowBalance")
+	assert.NotError(t, err)
+	assert.Equal(t, true, low)
+	_, err = row.String("Email")
+	assert.NotNil(t, err)
+
+	p := &person{}
+	assert.NotError(t, row.Decode(p))
+	assert.Equal(t, person{Name: "Tony", Age: 23, Balance: 1.5, LowBalance: true}, *p)
+
+	row, err = c.ReadLazy()
+	assert.NotError(t, err)
+	_, err = row.Int("Age")
+	assert.Equal(t, 3, err.(*FieldError).Line)
+	balance, err := row.Float("Balance")
+	assert.NotError(t, err)
+	assert.Equal(t, float64(0), balance)
+
+	_, err = c.ReadLazy()
+	assert.Equal(t, io.EOF, err)
+}
+
+func Test_ReadLazyTrimUnquoted(t *testing.T) {
+	// Prepare test
+	c := FromString("a, b ,\" c \"").Header(CsvHeader{"A": 0, "B": 1, "C": 2}).Whitespace(WhitespaceTrimUnquoted)
+
+	// Start test
+	row, err := c.ReadLazy()
+	assert.NotError(t, err)
+	b, _ := row.String("B")
+	assert.Equal(t, "b", b)
+	v, _ := row.String("C")
+	assert.Equal(t, " c ", v)
+}
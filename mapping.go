@@ -0,0 +1,143 @@
+package csvutil
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Converter transforms CSV column value before it's set on structure field.
+type Converter func(value string) (string, error)
+
+// URLDecode decodes percent-encoded value, e.g. "a%20b" to "a b".
+// Plus signs are left unchanged.
+func URLDecode(value string) (string, error) {
+	return url.PathUnescape(value)
+}
+
+// HTMLUnescape decodes HTML entities, e.g. "&amp;" to "&".
+func HTMLUnescape(value string) (string, error) {
+	return html.UnescapeString(value), nil
+}
+
+// Converters which can be referenced by name in Mapping.
+var (
+	convertersMu sync.RWMutex
+	converters   = map[string]Converter{
+		"trim":         func(v string) (string, error) { return strings.TrimSpace(v), nil },
+		"upper":        func(v string) (string, error) { return strings.ToUpper(v), nil },
+		"lower":        func(v string) (string, error) { return strings.ToLower(v), nil },
+		"urldecode":    URLDecode,
+		"htmlunescape": HTMLUnescape,
+		"annotation":   StripAnnotation(TrailingAnnotation),
+	}
+)
+
+// columnConverters maps CSV column name to converters applied to its values.
+type columnConverters map[string][]Converter
+
+// RegisterConverter registers converter under the name so it can be used in Mapping.
+func RegisterConverter(name string, fn Converter) {
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+	converters[name] = fn
+}
+
+// converter returns converter registered under the name.
+func converter(name string) (Converter, bool) {
+	convertersMu.RLock()
+	defer convertersMu.RUnlock()
+	fn, ok := converters[name]
+	return fn, ok
+}
+
+// ColumnConverter adds converters applied in order to values of the CSV
+// column before they are set on the structure field.
+//
+// Example:
+//
+//	// The column is HTML escaped twice.
+//	c.ColumnConverter("title", HTMLUnescape, HTMLUnescape)
+func (r *Reader) ColumnConverter(column string, fns ...Converter) *Reader {
+	if r.convs == nil {
+		r.convs = make(columnConverters)
+	}
+	r.convs[column] = append(r.convs[column], fns...)
+	return r
+}
+
+// MapEntry maps CSV column to the structure field.
+type MapEntry struct {
+	Field      string   `json:"field"`                // Structure field name
+	Column     string   `json:"column,omitempty"`     // CSV header column name
+	Index      int      `json:"index,omitempty"`      // Column index used when Column is empty
+	Converters []string `json:"converters,omitempty"` // Names of converters applied in order
+}
+
+// Mapping describes which CSV columns are set on which structure fields.
+// It can be built at runtime, e.g. from user choices in UI, and overrides
+// mapping defined by structure tags.
+type Mapping []MapEntry
+
+// Mapping sets mapping of CSV columns to structure fields. Only the fields
+// listed in the mapping are set. When any entry refers to the column by
+// name the first record is used as the header. Errors in the mapping,
+// like unknown fields or columns, are returned by SetData.
+func (r *Reader) Mapping(m Mapping) *Reader {
+	r.mapping = m
+	r.mapped = false
+	for _, e := range m {
+		if e.Column != "" {
+			r.headerRow = true
+		}
+	}
+	return r
+}
+
+// applyMapping sets CSV header and converters based on mapping.
+func (r *Reader) applyMapping(fields []*sField) error {
+	byName := make(map[string]*sField, len(fields))
+	for _, f := range fields {
+		byName[f.name] = f
+	}
+
+	pos := make(map[string]int, len(r.columns))
+	for i, name := range r.columns {
+		if _, ok := pos[name]; !ok {
+			pos[name] = i
+		}
+	}
+
+	h := make(CsvHeader, len(r.mapping))
+	if r.convs == nil {
+		r.convs = make(columnConverters)
+	}
+	for _, e := range r.mapping {
+		f, ok := byName[e.Field]
+		if !ok {
+			return fmt.Errorf("mapping: unknown field %s", e.Field)
+		}
+
+		idx := e.Index
+		if e.Column != "" {
+			if idx, ok = pos[e.Column]; !ok {
+				return fmt.Errorf("mapping: column %s not in CSV header", e.Column)
+			}
+		}
+		h[f.col] = idx
+
+		for _, name := range e.Converters {
+			conv, ok := converter(name)
+			if !ok {
+				return fmt.Errorf("mapping: unknown converter %s", name)
+			}
+			r.convs[f.col] = append(r.convs[f.col], conv)
+		}
+	}
+
+	r.Header(h)
+	r.mapped = true
+	return nil
+}
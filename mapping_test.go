@@ -0,0 +1,93 @@
+package csvutil
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_Mapping(t *testing.T) {
+	// Prepare test
+	var m Mapping
+	err := json.Unmarshal([]byte(`[
+		{"field": "Name", "column": "Full Name", "converters": ["trim", "upper"]},
+		{"field": "Age", "column": "Years"}
+	]`), &m)
+	assert.NotError(t, err)
+
+	sr := NewStringReadCloser("Years,Full Name,Balance\n23, Tony ,1.5")
+	c := NewCsvUtil(sr).Mapping(m)
+
+	// Start test
+	p := &person{Balance: 9}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person{Name: "TONY", Age: 23, Balance: 9}, *p)
+}
+
+func Test_MappingByIndex(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("x,Tony,23")
+	c := NewCsvUtil(sr).Mapping(Mapping{{Field: "Name", Index: 1}, {Field: "Age", Index: 2}})
+
+	// Start test
+	p := &person{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person{Name: "Tony", Age: 23}, *p)
+}
+
+func Test_MappingErrors(t *testing.T) {
+	// Prepare test
+	tests := []Mapping{
+		{{Field: "Missing", Index: 0}},
+		{{Field: "Name", Column: "Missing"}},
+		{{Field: "Name", Index: 0, Converters: []string{"missing"}}},
+	}
+
+	// Start test
+	for _, m := range tests {
+		c := NewCsvUtil(NewStringReadCloser("Name\nTony")).Mapping(m)
+		assert.NotNil(t, c.SetData(&person{}))
+	}
+}
+
+func Test_RegisterConverter(t *testing.T) {
+	// Prepare test
+	RegisterConverter("nonempty", func(v string) (string, error) {
+		if v == "" {
+			return "", errors.New("empty")
+		}
+		return v, nil
+	})
+	sr := NewStringReadCloser("Tony\n")
+	c := NewCsvUtil(sr).Mapping(Mapping{{Field: "Name", Converters: []string{"nonempty"}}})
+
+	// Start test
+	p := &person{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "Tony", p.Name)
+}
+
+func Test_ColumnConverter(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("Tom%20%26%20Jerry,C++ &amp;amp; Go")
+	c := NewCsvUtil(sr).
+		ColumnConverter("Field1", URLDecode).
+		ColumnConverter("Field2", HTMLUnescape, HTMLUnescape)
+
+	// Start test
+	a := &A{}
+	assert.NotError(t, c.SetData(a))
+	assert.Equal(t, A{Field1: "Tom & Jerry", Field2: "C++ & Go"}, *a)
+}
+
+func Test_ColumnConverterError(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("100%,x")
+	c := NewCsvUtil(sr).ColumnConverter("Field1", URLDecode)
+
+	// Start test
+	err := c.SetData(&A{})
+	assert.Equal(t, "Field1", err.(*FieldError).Column)
+	assert.Equal(t, "100%", err.(*FieldError).Value)
+}
@@ -0,0 +1,92 @@
+package csvutil
+
+import (
+	"io"
+)
+
+// ReadAllMaps reads the whole CSV file from r into maps keyed by the header
+// names from the first record. When d is nil the dialect is detected from
+// the beginning of the file. Values equal to the dialect Null are empty.
+// Where header names repeat, the first column wins.
+//
+// Example:
+//
+//	rows, err := csvutil.ReadAllMaps(f, nil)
+//	for _, row := range rows {
+//		fmt.Println(row["Name"])
+//	}
+func ReadAllMaps(r io.Reader, d *Dialect) ([]map[string]string, error) {
+	if d == nil {
+		detected, br, err := sniffDialect(r)
+		if err != nil {
+			return nil, err
+		}
+		d, r = &detected, br
+	}
+
+	c := NewCsvUtil(r).OwnsSource(false).Dialect(*d).FieldsPerRecord(-1)
+	header, err := c.read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	header = append([]string(nil), header...)
+
+	var rows []map[string]string
+	for {
+		record, err := c.read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]string, len(header))
+		for i, value := range record {
+			if i >= len(header) {
+				break
+			}
+			if _, ok := row[header[i]]; ok {
+				continue
+			}
+			if d.Null != "" && value == d.Null {
+				value = ""
+			}
+			row[header[i]] = value
+		}
+		rows = append(rows, row)
+	}
+}
+
+// ReadMap reads the next record into map keyed by column names. Names come
+// from the CSV header so HeaderFromFirstRow or NumberedHeader must be set.
+// Values equal to the Null sentinel are empty. Where names repeat, the
+// first column wins. Returns io.EOF when no more records exist.
+func (r *Reader) ReadMap() (map[string]string, error) {
+	if err := r.needColumns(); err != nil {
+		return nil, err
+	}
+
+	record, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+
+	row := make(map[string]string, len(r.columns))
+	for i, value := range record {
+		if i >= len(r.columns) {
+			break
+		}
+		if _, ok := row[r.columns[i]]; ok {
+			continue
+		}
+		if r.null != "" && value == r.null {
+			value = ""
+		}
+		row[r.columns[i]] = value
+	}
+	return row, nil
+}
@@ -0,0 +1,25 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+func Test_ReadAllMaps(t *testing.T) {
+	// Start test
+	rows, err := ReadAllMaps(strings.NewReader("Name;Age;Name\nTony;23;x\nJohn;NULL;y\n"), nil)
+	assert.NotError(t, err)
+	assert.Equal(t, []map[string]string{
+		{"Name": "Tony", "Age": "23"},
+		{"Name": "John", "Age": "NULL"},
+	}, rows)
+
+	rows, err = ReadAllMaps(strings.NewReader("Name|Age\nJohn|NULL\n"), &Dialect{Delimiter: "|", Null: "NULL"})
+	assert.NotError(t, err)
+	assert.Equal(t, []map[string]string{{"Name": "John", "Age": ""}}, rows)
+
+	rows, err = ReadAllMaps(strings.NewReader(""), nil)
+	assert.NotError(t, err)
+	assert.Equal(t, 0, len(rows))
+}
@@ -0,0 +1,80 @@
+package csvutil
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Sequenced is row tagged with its position in the input, starting from 0.
+type Sequenced struct {
+	Seq int
+	Row interface{}
+}
+
+// MergeOrdered collects rows processed by parallel workers from their
+// channels and passes them to the sink in the order of Seq. Rows which
+// arrive early are kept until all rows before them are sunk. It returns
+// when all channels are closed, the sink fails or ctx is done. Workers
+// must then stop sending. Returns error when sequence numbers repeat or
+// some are missing.
+//
+// Example:
+//
+//	err := csvutil.MergeOrdered(ctx, csvutil.WriterSink(f, csvutil.Dialect{}), out1, out2, out3)
+func MergeOrdered(ctx context.Context, sink Sink, workers ...<-chan Sequenced) error {
+	done := make(chan struct{})
+	defer close(done)
+
+	merged := make(chan Sequenced)
+	var wg sync.WaitGroup
+	for _, ch := range workers {
+		wg.Add(1)
+		go func(ch <-chan Sequenced) {
+			defer wg.Done()
+			for v := range ch {
+				select {
+				case merged <- v:
+				case <-done:
+					return
+				}
+			}
+		}(ch)
+	}
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+
+	next := 0
+	pending := make(map[int]interface{})
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case v, ok := <-merged:
+			if !ok {
+				if len(pending) > 0 {
+					return fmt.Errorf("merge: row %d missing", next)
+				}
+				return nil
+			}
+			if _, dup := pending[v.Seq]; dup || v.Seq < next {
+				return fmt.Errorf("merge: duplicate row %d", v.Seq)
+			}
+			pending[v.Seq] = v.Row
+
+			for {
+				row, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				if err := sink(ctx, row); err != nil {
+					return err
+				}
+				next++
+			}
+		}
+	}
+}
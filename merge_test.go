@@ -0,0 +1,48 @@
+package csvutil
+
+import (
+	"context"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+// sequence returns closed channel with rows of the given sequence numbers.
+func sequence(seqs ...int) <-chan Sequenced {
+	ch := make(chan Sequenced, len(seqs))
+	for _, seq := range seqs {
+		ch <- Sequenced{Seq: seq, Row: seq}
+	}
+	close(ch)
+	return ch
+}
+
+func Test_MergeOrdered(t *testing.T) {
+	// Prepare test
+	var got []interface{}
+	sink := func(ctx context.Context, v interface{}) error {
+		got = append(got, v)
+		return nil
+	}
+
+	// Start test
+	err := MergeOrdered(context.Background(), sink, sequence(1, 4, 5), sequence(0, 3), sequence(2, 6))
+	assert.NotError(t, err)
+	assert.Equal(t, []interface{}{0, 1, 2, 3, 4, 5, 6}, got)
+
+	err = MergeOrdered(context.Background(), sink, sequence(0, 2))
+	assert.Equal(t, "merge: row 1 missing", err.Error())
+
+	err = MergeOrdered(context.Background(), sink, sequence(0, 1), sequence(1))
+	assert.Equal(t, "merge: duplicate row 1", err.Error())
+}
+
+func Test_MergeOrderedCanceled(t *testing.T) {
+	// Prepare test
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	open := make(chan Sequenced)
+
+	// Start test
+	err := MergeOrdered(ctx, func(ctx context.Context, v interface{}) error { return nil }, open)
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,61 @@
+package csvutil
+
+import (
+	"errors"
+	"strings"
+)
+
+// toMinor converts decimal amount, e.g. "12.34", to integer number of minor
+// units, e.g. "1234", without going through float. The scale is the number
+// of decimal places of minor unit.
+func toMinor(value string, scale int) (string, error) {
+	sign := ""
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		if value[0] == '-' {
+			sign = "-"
+		}
+		value = value[1:]
+	}
+
+	parts := strings.SplitN(value, ".", 2)
+	whole, frac := parts[0], ""
+	if len(parts) == 2 {
+		frac = parts[1]
+	}
+
+	if whole == "" && frac == "" || !digits(whole) || !digits(frac) {
+		return "", errors.New("invalid amount")
+	}
+	if len(frac) > scale {
+		return "", errors.New("too many decimal places")
+	}
+
+	minor := strings.TrimLeft(whole+frac+strings.Repeat("0", scale-len(frac)), "0")
+	if minor == "" {
+		return "0", nil
+	}
+	return sign + minor, nil
+}
+
+// fromMinor formats integer number of minor units, e.g. "1234",
+// as decimal amount, e.g. "12.34".
+func fromMinor(value string, scale int) string {
+	sign := ""
+	if strings.HasPrefix(value, "-") {
+		sign, value = "-", value[1:]
+	}
+	if len(value) <= scale {
+		value = strings.Repeat("0", scale-len(value)+1) + value
+	}
+	return sign + value[:len(value)-scale] + "." + value[len(value)-scale:]
+}
+
+// digits returns true if s consists of ASCII digits only.
+func digits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
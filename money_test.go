@@ -0,0 +1,60 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type payment struct {
+	Who    string
+	Amount int64 `csv:"amount,cents"`
+}
+
+func Test_toMinor(t *testing.T) {
+	tests := map[string]string{
+		"12.34": "1234",
+		"12.3":  "1230",
+		"12":    "1200",
+		"-0.05": "-5",
+		"+1.00": "100",
+		".5":    "50",
+		"0":     "0",
+		"-0.00": "0",
+	}
+	for in, exp := range tests {
+		got, err := toMinor(in, 2)
+		assert.NotError(t, err)
+		assert.Equal(t, exp, got, in)
+	}
+
+	for _, in := range []string{"12.345", "1,00", "abc", ".", "-", "1e3"} {
+		_, err := toMinor(in, 2)
+		assert.NotNil(t, err, in)
+	}
+}
+
+func Test_fromMinor(t *testing.T) {
+	assert.Equal(t, "12.34", fromMinor("1234", 2))
+	assert.Equal(t, "0.05", fromMinor("5", 2))
+	assert.Equal(t, "-0.05", fromMinor("-5", 2))
+	assert.Equal(t, "0.00", fromMinor("0", 2))
+	assert.Equal(t, "-12.00", fromMinor("-1200", 2))
+}
+
+func Test_Cents(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("Tony,12.34\nJohn,0.1\nAnna,1.001")
+	c := NewCsvUtil(sr)
+
+	// Start test
+	p := &payment{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, int64(1234), p.Amount)
+	assert.Equal(t, "Tony,12.34", ToCsv(p, ",", "T", "F"))
+
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, int64(10), p.Amount)
+	assert.Equal(t, "John,0.10", ToCsv(p, ",", "T", "F"))
+
+	assert.NotNil(t, c.SetData(p))
+}
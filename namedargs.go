@@ -0,0 +1,70 @@
+package csvutil
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+)
+
+// errNoHeader is returned when reading needs column names from the header.
+var errNoHeader = errors.New("header is required, use HeaderFromFirstRow or NumberedHeader")
+
+// NamedArgs returns values of the struct v as sql.NamedArg values ready to
+// be passed to Exec or Query. Names are CSV column names of the fields,
+// see Headers.
+//
+// Example:
+//
+//	for c.SetData(p) == nil {
+//		_, err := stmt.Exec(csvutil.NamedArgs(p)...)
+//	}
+func NamedArgs(v interface{}) []interface{} {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		panic("Expected pointer to a struct")
+	}
+	fields, err := columnFields(value.Type())
+	if err != nil {
+		panic(err.Error())
+	}
+
+	args := make([]interface{}, len(fields))
+	for i, f := range fields {
+		col, _ := parseTag(f)
+		args[i] = sql.Named(col, value.FieldByName(f.Name).Interface())
+	}
+	return args
+}
+
+// ReadNamedArgs reads the next record and returns its values as sql.NamedArg
+// values, without decoding them into a structure.
+// Names come from the CSV header so HeaderFromFirstRow or NumberedHeader
+// must be set.
+// Values equal to the Null sentinel are passed as nil.
+// Returns io.EOF when no more records exist.
+func (r *Reader) ReadNamedArgs() ([]interface{}, error) {
+	if err := r.needColumns(); err != nil {
+		return nil, err
+	}
+
+	record, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]interface{}, 0, len(record))
+	for i, value := range record {
+		if i >= len(r.columns) {
+			break
+		}
+		var arg interface{} = value
+		if r.null != "" && value == r.null {
+			arg = nil
+		}
+		args = append(args, sql.Named(r.columns[i], arg))
+	}
+	return args, nil
+}
@@ -0,0 +1,36 @@
+package csvutil
+
+import (
+	"database/sql"
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"testing"
+)
+
+type dbRow struct {
+	Name  string `csv:"name"`
+	Age   int    `csv:"age"`
+	Notes string `csv:"-"`
+}
+
+func Test_NamedArgs(t *testing.T) {
+	// Start test
+	args := NamedArgs(&dbRow{Name: "Tony", Age: 23, Notes: "x"})
+	assert.Equal(t, []interface{}{sql.Named("name", "Tony"), sql.Named("age", 23)}, args)
+}
+
+func Test_ReadNamedArgs(t *testing.T) {
+	// Prepare test
+	c := FromString("name,age\nTony,NULL\n").HeaderFromFirstRow().Null("NULL")
+
+	// Start test
+	args, err := c.ReadNamedArgs()
+	assert.NotError(t, err)
+	assert.Equal(t, []interface{}{sql.Named("name", "Tony"), sql.Named("age", nil)}, args)
+
+	_, err = c.ReadNamedArgs()
+	assert.Equal(t, io.EOF, err)
+
+	_, err = FromString("Tony,1\n").ReadNamedArgs()
+	assert.Equal(t, errNoHeader, err)
+}
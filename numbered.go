@@ -0,0 +1,53 @@
+package csvutil
+
+import (
+	"strconv"
+)
+
+// NumberedHeader sets if columns of headerless files are named col_1,
+// col_2 and so on (default: false). The names are generated from the first
+// record, which stays a data record, and can be used wherever header names
+// are: struct tags, Mapping, ReadMap, ReadLazy, warnings and reports.
+// It takes precedence over HeaderFromFirstRow.
+//
+// Example:
+//
+//	type person struct {
+//		Name string `csv:"col_2"`
+//	}
+//
+//	r := csvutil.NewCsvUtil(f).NumberedHeader(true)
+func (r *Reader) NumberedHeader(b bool) *Reader {
+	r.numbered = b
+	return r
+}
+
+// numberColumns names columns of the record by position.
+func (r *Reader) numberColumns(record []string) {
+	names := make([]string, len(record))
+	for i := range names {
+		names[i] = "col_" + strconv.Itoa(i+1)
+	}
+	r.setColumns(names)
+}
+
+// needColumns makes sure column names are known before the next record
+// is read. With NumberedHeader they are generated by read.
+func (r *Reader) needColumns() error {
+	if r.columns != nil || r.numbered {
+		return nil
+	}
+	if !r.headerRow {
+		return errNoHeader
+	}
+	return r.readHeader()
+}
+
+// columnName returns name of the column i, #1, #2 and so on when the
+// column has no name.
+func (r *Reader) columnName(i int) string {
+	if i < len(r.columns) {
+		return r.columns[i]
+	}
+	return "#" + strconv.Itoa(i+1)
+}
@@ -0,0 +1,86 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"testing"
+)
+
+type numberedRow struct {
+	Name string `csv:"col_2"`
+	Age  int    `csv:"col_3"`
+}
+
+func Test_NumberedHeader(t *testing.T) {
+	// Prepare test
+	var warnings []Warning
+	c := FromString("1,Tony,23,x\n2,John,34,y\n").
+		NumberedHeader(true).
+		OnWarning(func(w Warning) { warnings = append(warnings, w) })
+
+	// Start test
+	got := &numberedRow{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, numberedRow{"Tony", 23}, *got)
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, numberedRow{"John", 34}, *got)
+	assert.Equal(t, []string{"col_1", "col_2", "col_3", "col_4"}, c.Columns())
+	assert.Equal(t, []Warning{{Line: 1, Message: "columns ignored: col_1, col_4"}}, warnings)
+}
+
+func Test_NumberedHeaderMapping(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,23\n").
+		NumberedHeader(true).
+		Mapping(Mapping{{Field: "Name", Column: "col_1"}, {Field: "Age", Column: "col_2"}})
+
+	// Start test
+	got := &warned{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, warned{"Tony", 23}, *got)
+}
+
+func Test_ReadMap(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,NULL\nJohn,34\n").NumberedHeader(true).Null("NULL")
+
+	// Start test
+	row, err := c.ReadMap()
+	assert.NotError(t, err)
+	assert.Equal(t, map[string]string{"col_1": "Tony", "col_2": ""}, row)
+
+	row, err = c.ReadMap()
+	assert.NotError(t, err)
+	assert.Equal(t, map[string]string{"col_1": "John", "col_2": "34"}, row)
+
+	_, err = c.ReadMap()
+	assert.Equal(t, io.EOF, err)
+
+	_, err = FromString("Tony,1\n").ReadMap()
+	assert.Equal(t, errNoHeader, err)
+}
+
+func Test_NumberedHeaderLazy(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,23\n").NumberedHeader(true)
+
+	// Start test
+	row, err := c.ReadLazy()
+	assert.NotError(t, err)
+	age, err := row.Int("col_2")
+	assert.NotError(t, err)
+	assert.Equal(t, int64(23), age)
+}
+
+func Test_NumberedHeaderProfile(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,23\nJohn,\n").NumberedHeader(true)
+
+	// Start test
+	s, err := c.Profile(false)
+	assert.NotError(t, err)
+	assert.Equal(t, 2, s.Rows)
+	assert.Equal(t, "col_1", s.Columns[0].Name)
+	assert.Equal(t, "col_2", s.Columns[1].Name)
+	assert.Equal(t, 1, s.Columns[1].Nulls)
+}
@@ -0,0 +1,64 @@
+package csvutil
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Numbers with underscores separating groups of digits, e.g. 1_000_000.
+var separatedNumber = regexp.MustCompile(`^[+-]?[0-9]+(_[0-9]+)*(\.[0-9]+(_[0-9]+)*)?([eE][+-]?[0-9]+)?$`)
+
+// LenientNumbers makes SetData accept numbers written with underscores
+// separating digits ("1_000_000") and integers written in scientific
+// notation ("1.2e6") as long as they represent exact integer value.
+func (r *Reader) LenientNumbers(b bool) *Reader {
+	r.lenient = b
+	return r
+}
+
+// stripSeparators removes underscores separating digits.
+func stripSeparators(value string) string {
+	if strings.Contains(value, "_") && separatedNumber.MatchString(value) {
+		return strings.Replace(value, "_", "", -1)
+	}
+	return value
+}
+
+// decimalRat parses decimal number, with optional digit separators and
+// exponent, as exact rational value. Fractions and hexadecimal numbers
+// accepted by big.Rat are rejected.
+func decimalRat(value string) (*big.Rat, bool) {
+	if !separatedNumber.MatchString(value) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(strings.Replace(value, "_", "", -1))
+}
+
+// lenientInt parses integer which failed strict parsing with err.
+func lenientInt(value string, err error) (int64, error) {
+	rat, ok := decimalRat(value)
+	if !ok || !rat.IsInt() || !rat.Num().IsInt64() {
+		return 0, err
+	}
+	return rat.Num().Int64(), nil
+}
+
+// lenientUint parses unsigned integer which failed strict parsing with err.
+func lenientUint(value string, err error) (uint64, error) {
+	rat, ok := decimalRat(value)
+	if !ok || !rat.IsInt() || !rat.Num().IsUint64() {
+		return 0, err
+	}
+	return rat.Num().Uint64(), nil
+}
+
+// lenientFloat parses float which failed strict parsing with err.
+func lenientFloat(value string, err error) (float64, error) {
+	f64, ferr := strconv.ParseFloat(stripSeparators(value), 64)
+	if ferr != nil {
+		return 0, err
+	}
+	return f64, nil
+}
@@ -0,0 +1,59 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type measurement struct {
+	Count int64
+	Size  uint
+	Value float64
+}
+
+func Test_LenientNumbers(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("1.2e6,1_000,1_000.5\n-2E3,3e0,1e3\n1.5e0,1,1")
+	c := NewCsvUtil(sr).LenientNumbers(true)
+
+	// Start test
+	m := &measurement{}
+	assert.NotError(t, c.SetData(m))
+	assert.Equal(t, measurement{Count: 1200000, Size: 1000, Value: 1000.5}, *m)
+
+	assert.NotError(t, c.SetData(m))
+	assert.Equal(t, measurement{Count: -2000, Size: 3, Value: 1000}, *m)
+
+	// Not exact integer.
+	assert.NotNil(t, c.SetData(m))
+}
+
+func Test_LenientNumbersNotDecimal(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("4/2,1,1\n1,0x10,1\n1,8/2,1")
+	c := NewCsvUtil(sr).LenientNumbers(true)
+
+	// Start test
+	m := &measurement{}
+	assert.NotNil(t, c.SetData(m))
+	assert.NotNil(t, c.SetData(m))
+	assert.NotNil(t, c.SetData(m))
+}
+
+func Test_StrictNumbers(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("1.2e6,1,1\n1,1_000,1")
+	c := NewCsvUtil(sr)
+
+	// Start test
+	m := &measurement{}
+	assert.NotNil(t, c.SetData(m))
+	assert.NotNil(t, c.SetData(m))
+}
+
+func Test_stripSeparators(t *testing.T) {
+	assert.Equal(t, "1000000", stripSeparators("1_000_000"))
+	assert.Equal(t, "-1000.25", stripSeparators("-1_000.2_5"))
+	assert.Equal(t, "1__0", stripSeparators("1__0"))
+	assert.Equal(t, "_1", stripSeparators("_1"))
+}
@@ -0,0 +1,31 @@
+package csvutil
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// omitIf evaluates omitif condition expr against the structure value v.
+func omitIf(v reflect.Value, expr string) (bool, error) {
+	op := "=="
+	i := strings.Index(expr, op)
+	if j := strings.Index(expr, "!="); j >= 0 && (i < 0 || j < i) {
+		op, i = "!=", j
+	}
+	if i <= 0 {
+		return false, fmt.Errorf("invalid omitif condition %q", expr)
+	}
+
+	name, want := expr[:i], expr[i+len(op):]
+	field := v.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		return false, fmt.Errorf("omitif: unknown field %s", name)
+	}
+
+	got := getValue(field, "true", "false")
+	if op == "==" {
+		return got == want, nil
+	}
+	return got != want, nil
+}
@@ -0,0 +1,35 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"reflect"
+	"testing"
+)
+
+type exportOrder struct {
+	Status string
+	Margin string `csv:"margin,omitif=Status==draft"`
+	Notes  string `csv:"notes,omitif=Public!=true"`
+	Public bool
+}
+
+func Test_OmitIf(t *testing.T) {
+	// Start test
+	assert.Equal(t, "draft,,,F", ToCsv(&exportOrder{Status: "draft", Margin: "10", Notes: "n"}, ",", "T", "F"))
+	assert.Equal(t, "sent,10,n,T", ToCsv(&exportOrder{Status: "sent", Margin: "10", Notes: "n", Public: true}, ",", "T", "F"))
+}
+
+func Test_OmitIfInvalid(t *testing.T) {
+	// Prepare test
+	type badOrder struct {
+		A string `csv:"a,omitif=B=x"`
+		C string `csv:"c,omitif=Missing==x"`
+	}
+
+	// Start test
+	_, err := omitIf(reflect.ValueOf(badOrder{}), "B=x")
+	assert.NotNil(t, err)
+	_, err = omitIf(reflect.ValueOf(badOrder{}), "Missing==x")
+	assert.NotNil(t, err)
+	assert.Panic(t, func() { ToCsv(&badOrder{}, ",", "T", "F") }, "Expected ToCsv to panic on invalid condition")
+}
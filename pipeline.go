@@ -0,0 +1,367 @@
+package csvutil
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// Source opens the input of the Pipeline.
+type Source func(ctx context.Context) (io.ReadCloser, error)
+
+// Sink receives rows which made it through the Pipeline.
+type Sink func(ctx context.Context, v interface{}) error
+
+// FileSource returns Source reading the file at path. Compressed files are
+// decompressed, see Decompress.
+func FileSource(path string) Source {
+	return func(ctx context.Context) (io.ReadCloser, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		return Decompress(f)
+	}
+}
+
+// URLSource returns Source reading the resource at url, see OpenURL.
+func URLSource(url string, opts *URLOptions) Source {
+	return func(ctx context.Context) (io.ReadCloser, error) {
+		return OpenURL(ctx, url, opts)
+	}
+}
+
+// ReaderSource returns Source reading from r. The r is not closed.
+func ReaderSource(r io.Reader) Source {
+	return func(ctx context.Context) (io.ReadCloser, error) {
+		return ioutil.NopCloser(r), nil
+	}
+}
+
+// WriterSink returns Sink writing rows to w as CSV records in dialect d.
+// Every row is flushed to w before the sink returns.
+func WriterSink(w io.Writer, d Dialect) Sink {
+	cw := d.NewWriter(w)
+	t, f := d.boolValues()
+	return func(ctx context.Context, v interface{}) error {
+		record, err := toRecord(v, t, f, false, false, nil)
+		if err != nil {
+			return err
+		}
+		if err = cw.Write(record); err != nil {
+			return err
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+}
+
+// SQLSink returns Sink executing query for every row with the row fields
+// passed as named arguments, see NamedArgs.
+func SQLSink(db *sql.DB, query string) Sink {
+	return func(ctx context.Context, v interface{}) error {
+		_, err := db.ExecContext(ctx, query, NamedArgs(v)...)
+		return err
+	}
+}
+
+// HTTPSink returns Sink posting every row to url as CSV record in dialect d
+// with text/csv content type. Client, Header and Timeout of opts are used,
+// opts may be nil. Requests are not retried as the server may have stored
+// the row already. Responses with status other than 2xx fail the sink with
+// StatusError.
+func HTTPSink(url string, d Dialect, opts *URLOptions) Sink {
+	if opts == nil {
+		opts = &URLOptions{}
+	}
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	t, f := d.boolValues()
+	return func(ctx context.Context, v interface{}) error {
+		record, err := toRecord(v, t, f, false, false, nil)
+		if err != nil {
+			return err
+		}
+		var body bytes.Buffer
+		cw := d.NewWriter(&body)
+		cw.Write(record)
+		cw.Flush()
+		if err = cw.Error(); err != nil {
+			return err
+		}
+
+		if opts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+			defer cancel()
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &body)
+		if err != nil {
+			return err
+		}
+		for k, v := range opts.Header {
+			req.Header[k] = v
+		}
+		req.Header.Set("Content-Type", "text/csv")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		// Drain the body so the connection can be reused.
+		io.Copy(ioutil.Discard, resp.Body)
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return &StatusError{URL: url, StatusCode: resp.StatusCode}
+		}
+		return nil
+	}
+}
+
+// SyncSink returns Sink safe for use by multiple goroutines which passes
+// rows to s one at a time. Sinks returned by WriterSink flush every row
+// before returning so rows written through SyncSink are never interleaved.
+//
+// Example:
+//
+//	sink := csvutil.SyncSink(csvutil.WriterSink(f, csvutil.Dialect{}))
+//	for i := 0; i < workers; i++ {
+//		go func() {
+//			for v := range rows {
+//				sink(ctx, process(v))
+//			}
+//		}()
+//	}
+func SyncSink(s Sink) Sink {
+	var mu sync.Mutex
+	return func(ctx context.Context, v interface{}) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return s(ctx, v)
+	}
+}
+
+// PipelineReport summarizes the Pipeline run.
+type PipelineReport struct {
+	Rows         int            // Number of data records read
+	Filtered     int            // Rows dropped by filters
+	Written      int            // Rows passed to the sink
+	Errors       []*RowError    // Rows which failed to decode, validate or transform
+	ColumnErrors map[string]int // Number of failures by column
+}
+
+// Pipeline reads CSV rows from the source, filters, transforms and
+// validates them and passes them to the sink.
+//
+// Example:
+//
+//	report, err := csvutil.NewPipeline(csvutil.FileSource("people.csv.gz"), func() interface{} { return &person{} }).
+//		Dialect(csvutil.Dialect{Delimiter: ";"}).
+//		Filter(func(v interface{}) bool { return v.(*person).Age >= 18 }).
+//		Sink(csvutil.SQLSink(db, "INSERT INTO people VALUES (:Name, :Age)")).
+//		Run(ctx)
+type Pipeline struct {
+	source       Source                      // Opens the input
+	newRow       func() interface{}          // Returns pointer to new row struct
+	dialect      *Dialect                    // Dialect of the input, nil for defaults
+	configure    []func(r *Reader)           // Reader setup functions
+	filters      []func(v interface{}) bool  // Decide which rows are kept
+	transforms   []func(v interface{}) error // Modify kept rows
+	validators   []RowValidator              // Validate decoded rows
+	sink         Sink                        // Receives the rows
+	maxRate      float64                     // Maximum share of failed rows
+	minRows      int                         // Rows read before the rate is checked
+	rateSet      bool                        // True if the error rate is limited
+	columnLimits map[string]int              // Maximum number of failures by column
+	twoPhase     bool                        // True if all rows are validated before any is sunk
+}
+
+// NewPipeline returns Pipeline reading rows from src. The newRow returns
+// pointer to new struct for each row.
+func NewPipeline(src Source, newRow func() interface{}) *Pipeline {
+	return &Pipeline{source: src, newRow: newRow}
+}
+
+// Dialect sets the dialect of the source.
+func (p *Pipeline) Dialect(d Dialect) *Pipeline {
+	p.dialect = &d
+	return p
+}
+
+// Configure adds function setting up the Reader before the first row is
+// read, e.g. to set the header or column mapping.
+func (p *Pipeline) Configure(fn func(r *Reader)) *Pipeline {
+	p.configure = append(p.configure, fn)
+	return p
+}
+
+// Filter adds function deciding if the row should be kept.
+func (p *Pipeline) Filter(fn func(v interface{}) bool) *Pipeline {
+	p.filters = append(p.filters, fn)
+	return p
+}
+
+// Transform adds function modifying kept rows. Rows for which it returns
+// an error are reported and not passed to the sink.
+func (p *Pipeline) Transform(fn func(v interface{}) error) *Pipeline {
+	p.transforms = append(p.transforms, fn)
+	return p
+}
+
+// Validate adds row validator, see Reader.ValidateRow.
+func (p *Pipeline) Validate(fn RowValidator) *Pipeline {
+	p.validators = append(p.validators, fn)
+	return p
+}
+
+// Sink sets where the rows go. Rows are only counted when sink is not set.
+func (p *Pipeline) Sink(s Sink) *Pipeline {
+	p.sink = s
+	return p
+}
+
+// Run reads all rows from the source. Rows which fail to decode, validate
+// or transform are listed in the report and the run continues. Returned
+// error is not nil when the source can't be read, sink fails, failures
+// exceed the thresholds or ctx is done, the report then describes rows
+// processed so far.
+func (p *Pipeline) Run(ctx context.Context) (*PipelineReport, error) {
+	if p.twoPhase {
+		return p.runTwoPhase(ctx)
+	}
+	return p.run(ctx, false, nil)
+}
+
+// run reads all rows from the source. When check is true rows are only
+// decoded and validated and offsets of the failed ones are added to failed.
+// Otherwise rows at offsets in failed are skipped.
+func (p *Pipeline) run(ctx context.Context, check bool, failed map[int64]bool) (*PipelineReport, error) {
+	rc, err := p.source(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := NewCsvUtil(rc)
+	defer r.Close()
+
+	if p.dialect != nil {
+		r.Dialect(*p.dialect)
+	}
+	for _, fn := range p.configure {
+		fn(r)
+	}
+	// Results of row validators are known from the check.
+	if check || failed == nil {
+		for _, fn := range p.validators {
+			r.ValidateRow(fn)
+		}
+	}
+
+	report := &PipelineReport{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		if !check && failed != nil {
+			skip, err := skipFailed(r, failed)
+			if err == io.EOF {
+				return report, p.checkThresholds(report, true)
+			}
+			if err != nil {
+				return report, err
+			}
+			if skip {
+				report.Rows++
+				continue
+			}
+		}
+
+		v := p.newRow()
+		err := r.SetData(v)
+		if err == io.EOF {
+			return report, p.checkThresholds(report, true)
+		}
+		if err == ErrBatchBoundary {
+			continue
+		}
+		report.Rows++
+		if err != nil {
+			if _, ok := err.(*csv.ParseError); !ok && r.csvLine == nil {
+				return report, err
+			}
+			report.fail(err)
+			if check {
+				failed[r.offset] = true
+			}
+			if err := p.checkThresholds(report, false); err != nil {
+				return report, err
+			}
+			continue
+		}
+		if check {
+			continue
+		}
+
+		if !p.keep(v) {
+			report.Filtered++
+			continue
+		}
+		if err := p.transform(v); err != nil {
+			report.fail(err)
+			if err := p.checkThresholds(report, false); err != nil {
+				return report, err
+			}
+			continue
+		}
+
+		if p.sink != nil {
+			if err := p.sink(ctx, v); err != nil {
+				return report, err
+			}
+		}
+		report.Written++
+	}
+}
+
+// keep returns true if all filters keep the row v.
+func (p *Pipeline) keep(v interface{}) bool {
+	for _, fn := range p.filters {
+		if !fn(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// transform applies transformers to the row v.
+func (p *Pipeline) transform(v interface{}) error {
+	for _, fn := range p.transforms {
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fail adds error of the last row to the report.
+func (pr *PipelineReport) fail(err error) {
+	if fe, ok := err.(*FieldError); ok {
+		if pr.ColumnErrors == nil {
+			pr.ColumnErrors = make(map[string]int)
+		}
+		pr.ColumnErrors[fe.Column]++
+	}
+	re, ok := err.(*RowError)
+	if !ok {
+		re = &RowError{Row: pr.Rows, Err: err}
+	}
+	pr.Errors = append(pr.Errors, re)
+}
@@ -0,0 +1,132 @@
+package csvutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func Test_Pipeline(t *testing.T) {
+	// Prepare test
+	var buf bytes.Buffer
+	src := ReaderSource(strings.NewReader("Tony;23;1.5;Y\nJohn;x;2;N\nAnn;12;3;N\nJo;40;4;N\n"))
+	p := NewPipeline(src, func() interface{} { return &person{} }).
+		Dialect(Dialect{Delimiter: ";", True: []string{"Y"}, False: []string{"N"}}).
+		Filter(func(v interface{}) bool { return v.(*person).Age >= 18 }).
+		Transform(func(v interface{}) error {
+			v.(*person).Name = strings.ToUpper(v.(*person).Name)
+			return nil
+		}).
+		Validate(func(row int, v interface{}) error {
+			if v.(*person).Name == "Jo" {
+				return errors.New("name too short")
+			}
+			return nil
+		}).
+		Sink(WriterSink(&buf, Dialect{}))
+
+	// Start test
+	report, err := p.Run(context.Background())
+	assert.NotError(t, err)
+	assert.Equal(t, 4, report.Rows)
+	assert.Equal(t, 1, report.Filtered)
+	assert.Equal(t, 1, report.Written)
+	assert.Equal(t, 2, len(report.Errors))
+	assert.Equal(t, 2, report.Errors[0].Row)
+	assert.Equal(t, 4, report.Errors[1].Row)
+	assert.Equal(t, "TONY,23,1.5,true\n", buf.String())
+}
+
+func Test_WriterSink(t *testing.T) {
+	// Prepare test
+	var buf bytes.Buffer
+	sink := WriterSink(&buf, Dialect{Delimiter: ";", CRLF: true})
+
+	// Start test
+	assert.NotError(t, sink(context.Background(), &person2{Name: "Smith; \"John\"", Balance: 1.5}))
+	assert.NotError(t, sink(context.Background(), &account{Number: "00123", Zip: "01234", Owner: "Ann"}))
+	assert.Equal(t, "\"Smith; \"\"John\"\"\";1.5\r\n00123;\"=\"\"01234\"\"\";Ann\r\n", buf.String())
+}
+
+func Test_PipelineSinkError(t *testing.T) {
+	// Prepare test
+	src := ReaderSource(strings.NewReader("Tony,23,1.5,true\nJohn,34,2,false\n"))
+	p := NewPipeline(src, func() interface{} { return &person{} }).
+		Sink(func(ctx context.Context, v interface{}) error { return errors.New("down") })
+
+	// Start test
+	report, err := p.Run(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, report.Rows)
+	assert.Equal(t, 0, report.Written)
+}
+
+func Test_PipelineCanceled(t *testing.T) {
+	// Prepare test
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	src := ReaderSource(strings.NewReader("Tony,23,1.5,true\n"))
+
+	// Start test
+	report, err := NewPipeline(src, func() interface{} { return &person{} }).Run(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0, report.Rows)
+}
+
+func Test_SyncSink(t *testing.T) {
+	// Prepare test
+	var buf bytes.Buffer
+	sink := SyncSink(WriterSink(&buf, Dialect{}))
+	var wg sync.WaitGroup
+
+	// Start test
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				sink(context.Background(), &person2{Name: "Tony", Balance: 1.5})
+			}
+		}()
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Equal(t, 400, len(lines))
+	for _, line := range lines {
+		assert.Equal(t, "Tony,1.5", line)
+	}
+}
+
+func Test_HTTPSink(t *testing.T) {
+	// Prepare test
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Header.Get("Content-Type") != "text/csv" || r.Header.Get("X-Token") != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if strings.HasPrefix(string(body), "Fail") {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		got = append(got, string(body))
+	}))
+	defer srv.Close()
+	sink := HTTPSink(srv.URL, Dialect{Delimiter: ";"}, &URLOptions{Header: http.Header{"X-Token": {"secret"}}})
+
+	// Start test
+	assert.NotError(t, sink(context.Background(), &person{Name: "Tony", Age: 23, Balance: 1.5, LowBalance: true}))
+	assert.Equal(t, []string{"Tony;23;1.5;true\n"}, got)
+
+	err := sink(context.Background(), &person{Name: "Fail"})
+	assert.Equal(t, &StatusError{URL: srv.URL, StatusCode: http.StatusConflict}, err)
+}
@@ -0,0 +1,108 @@
+package csvutil
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"unicode/utf8"
+)
+
+// PreviewMaxLen is the maximum number of characters of values in Preview.
+// Longer values are truncated.
+var PreviewMaxLen = 64
+
+// Preview is the beginning of CSV file meant for "map your columns" UIs.
+type Preview struct {
+	Dialect Dialect    // Detected dialect
+	Header  []string   // The first record
+	Rows    [][]string // Following records with long values truncated
+	Types   []string   // Type guessed for each column, one of Type* constants
+
+	Candidates [][]Candidate // Candidate types of each column, see DetectTypes
+}
+
+// ReadPreview returns header and at most rows following records of the CSV
+// file read from r. The dialect is detected and column types are guessed
+// from previewed values.
+func ReadPreview(r io.Reader, rows int) (*Preview, error) {
+	br := bufio.NewReaderSize(r, 64*1024)
+	sample, err := br.Peek(64 * 1024)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+
+	p := &Preview{Dialect: DetectDialect(sample)}
+	c := NewCsvUtil(br).Dialect(p.Dialect).FieldsPerRecord(-1)
+
+	if p.Header, err = c.read(); err != nil {
+		if err == io.EOF {
+			return p, nil
+		}
+		return nil, err
+	}
+
+	records, err := c.Head(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([][]string, len(p.Header))
+	for _, record := range records {
+		for i, value := range record {
+			if i < len(columns) {
+				columns[i] = append(columns[i], value)
+			}
+			record[i] = truncate(value, PreviewMaxLen)
+		}
+		p.Rows = append(p.Rows, record)
+	}
+
+	for _, values := range columns {
+		p.Types = append(p.Types, guessType(values))
+	}
+	p.Candidates = DetectTypes(columns)
+
+	return p, nil
+}
+
+// truncate shortens value to at most n characters marking it with ellipsis.
+func truncate(value string, n int) string {
+	if utf8.RuneCountInString(value) <= n {
+		return value
+	}
+	return string([]rune(value)[:n-1]) + "…"
+}
+
+// guessType returns the most specific type all non empty values can be parsed as.
+func guessType(values []string) string {
+	isInt, isFloat, isBool := true, true, true
+	empty := true
+
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		empty = false
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+			isInt = false
+		}
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			isFloat = false
+		}
+		if _, err := strconv.ParseBool(v); err != nil {
+			isBool = false
+		}
+	}
+
+	switch {
+	case empty:
+		return TypeString
+	case isBool && !isInt:
+		return TypeBool
+	case isInt:
+		return TypeInt
+	case isFloat:
+		return TypeFloat
+	}
+	return TypeString
+}
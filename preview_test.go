@@ -0,0 +1,39 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+func Test_ReadPreview(t *testing.T) {
+	// Prepare test
+	data := "Name;Age;Balance;Active;Note\n" +
+		"Tony;23;1.5;true;" + strings.Repeat("x", 100) + "\n" +
+		"John;;2;false;short\n" +
+		"Anna;40;3;true;\n"
+
+	// Start test
+	p, err := ReadPreview(strings.NewReader(data), 2)
+	assert.NotError(t, err)
+	assert.Equal(t, ";", p.Dialect.Delimiter)
+	assert.Equal(t, []string{"Name", "Age", "Balance", "Active", "Note"}, p.Header)
+	assert.Equal(t, 2, len(p.Rows))
+	assert.Equal(t, strings.Repeat("x", 63)+"…", p.Rows[0][4])
+	assert.Equal(t, []string{TypeString, TypeInt, TypeFloat, TypeBool, TypeString}, p.Types)
+}
+
+func Test_ReadPreviewEmpty(t *testing.T) {
+	// Start test
+	p, err := ReadPreview(strings.NewReader(""), 2)
+	assert.NotError(t, err)
+	assert.Equal(t, 0, len(p.Header))
+}
+
+func Test_guessType(t *testing.T) {
+	assert.Equal(t, TypeInt, guessType([]string{"1", "0", ""}))
+	assert.Equal(t, TypeBool, guessType([]string{"true", "F"}))
+	assert.Equal(t, TypeFloat, guessType([]string{"1", "1.5"}))
+	assert.Equal(t, TypeString, guessType([]string{"1", "a"}))
+	assert.Equal(t, TypeString, guessType([]string{""}))
+}
@@ -0,0 +1,175 @@
+package csvutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Masks applied to values by ExportProfile.
+const (
+	MaskRedact       = "redact"       // Value is replaced with asterisks of the same length
+	MaskLast4        = "last4"        // All but the last 4 characters are replaced with asterisks
+	MaskEmpty        = "empty"        // Value is written empty
+	MaskPseudonymize = "pseudonymize" // Value is replaced with pseudonym, see RegisterPseudonymizer
+)
+
+// ProfileColumn describes single column of the export.
+type ProfileColumn struct {
+	Field  string `json:"field"`            // Structure field name
+	Header string `json:"header,omitempty"` // Header name, the field column name when empty
+	Format string `json:"format,omitempty"` // Time layout for time.Time fields, fmt verb for numbers
+	Mask   string `json:"mask,omitempty"`   // One of Mask* constants
+}
+
+// ExportProfile describes file format expected by one consumer of the data:
+// which fields are exported, in what order, under which header names,
+// how they are formatted and masked. Profiles are data so they can be
+// maintained in configuration files.
+type ExportProfile struct {
+	Name    string          `json:"name"`
+	Dialect Dialect         `json:"dialect"`
+	Columns []ProfileColumn `json:"columns"`
+}
+
+// Profiles are export profiles by name.
+type Profiles map[string]*ExportProfile
+
+// LoadProfiles reads JSON array of export profiles.
+//
+// Example:
+//
+//	profiles, err := csvutil.LoadProfiles(f)
+//	p, err := profiles.Get("partner-a")
+//	w := p.Dialect.NewWriter(out)
+//	w.Write(p.Header(&order{}))
+//	record, err := p.Record(o)
+func LoadProfiles(r io.Reader) (Profiles, error) {
+	var list []*ExportProfile
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return nil, err
+	}
+	profiles := make(Profiles, len(list))
+	for _, p := range list {
+		if _, ok := profiles[p.Name]; ok {
+			return nil, fmt.Errorf("duplicate profile %s", p.Name)
+		}
+		profiles[p.Name] = p
+	}
+	return profiles, nil
+}
+
+// Get returns profile by name.
+func (ps Profiles) Get(name string) (*ExportProfile, error) {
+	p, ok := ps[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile %s", name)
+	}
+	return p, nil
+}
+
+// Header returns header record of the export of structures like v.
+func (p *ExportProfile) Header(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	header := make([]string, len(p.Columns))
+	for i, c := range p.Columns {
+		header[i] = c.Header
+		if header[i] == "" {
+			header[i] = c.Field
+			if f, ok := t.FieldByName(c.Field); ok {
+				header[i], _ = parseTag(f)
+			}
+		}
+	}
+	return header
+}
+
+// Record returns record of the export for the structure v.
+func (p *ExportProfile) Record(v interface{}) ([]string, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		panic("Expected pointer to a struct")
+	}
+
+	record := make([]string, len(p.Columns))
+	for i, c := range p.Columns {
+		field := value.FieldByName(c.Field)
+		if !field.IsValid() || !field.CanInterface() {
+			return nil, fmt.Errorf("profile %s: unknown field %s", p.Name, c.Field)
+		}
+		str, err := p.format(field, c.Format)
+		if err != nil {
+			return nil, fmt.Errorf("profile %s: field %s: %v", p.Name, c.Field, err)
+		}
+		if record[i], err = mask(str, c.Mask); err != nil {
+			return nil, fmt.Errorf("profile %s: field %s: %v", p.Name, c.Field, err)
+		}
+	}
+	return record, nil
+}
+
+// format returns the field value formatted according to layout.
+func (p *ExportProfile) format(field reflect.Value, layout string) (string, error) {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return "", nil
+		}
+		field = field.Elem()
+	}
+
+	if layout != "" {
+		if t, ok := field.Interface().(time.Time); ok {
+			return t.Format(layout), nil
+		}
+		switch field.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return fmt.Sprintf(layout, field.Interface()), nil
+		}
+	}
+
+	if field.Kind() == reflect.Bool {
+		t, f := p.Dialect.boolValues()
+		return getValue(field, t, f), nil
+	}
+	return formatValue(field)
+}
+
+// mask returns value with mask applied.
+func mask(value, mask string) (string, error) {
+	switch mask {
+	case "":
+		return value, nil
+	case MaskRedact:
+		return strings.Repeat("*", utf8.RuneCountInString(value)), nil
+	case MaskLast4:
+		runes := []rune(value)
+		if len(runes) <= 4 {
+			return value, nil
+		}
+		return strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-4:]), nil
+	case MaskEmpty:
+		return "", nil
+	case MaskPseudonymize:
+		pseudonym, err := pseudonymize(value)
+		if err != nil {
+			return "", err
+		}
+		if value == "" {
+			return value, nil
+		}
+		return pseudonym, nil
+	}
+	return "", fmt.Errorf("unknown mask %s", mask)
+}
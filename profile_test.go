@@ -0,0 +1,71 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testProfiles = `[
+	{"name": "internal", "columns": [
+		{"field": "ID"},
+		{"field": "Card"},
+		{"field": "Amount"}
+	]},
+	{"name": "partner", "dialect": {"delimiter": ";", "true": ["Y"], "false": ["N"]}, "columns": [
+		{"field": "Created", "header": "Date", "format": "02.01.2006"},
+		{"field": "Amount", "header": "Total", "format": "%.2f"},
+		{"field": "Card", "header": "Card", "mask": "last4"},
+		{"field": "Paid", "header": "Paid"}
+	]}
+]`
+
+type exportPayment struct {
+	ID      int    `csv:"id"`
+	Card    string `csv:"card"`
+	Amount  float64
+	Paid    bool
+	Created time.Time
+}
+
+func Test_ExportProfile(t *testing.T) {
+	// Prepare test
+	profiles, err := LoadProfiles(strings.NewReader(testProfiles))
+	assert.NotError(t, err)
+	v := &exportPayment{ID: 7, Card: "4111111111111111", Amount: 12.5, Paid: true, Created: time.Date(2016, 3, 4, 0, 0, 0, 0, time.UTC)}
+
+	// Start test
+	p, err := profiles.Get("internal")
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"id", "card", "Amount"}, p.Header(v))
+	record, err := p.Record(v)
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"7", "4111111111111111", "12.5"}, record)
+
+	p, err = profiles.Get("partner")
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"Date", "Total", "Card", "Paid"}, p.Header(v))
+	record, err = p.Record(v)
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"04.03.2016", "12.50", "************1111", "Y"}, record)
+
+	_, err = profiles.Get("missing")
+	assert.NotNil(t, err)
+}
+
+func Test_ExportProfileErrors(t *testing.T) {
+	// Prepare test
+	p := &ExportProfile{Name: "bad", Columns: []ProfileColumn{{Field: "Missing"}}}
+
+	// Start test
+	_, err := p.Record(&exportPayment{})
+	assert.Equal(t, "profile bad: unknown field Missing", err.Error())
+
+	p.Columns = []ProfileColumn{{Field: "Card", Mask: "rot13"}}
+	_, err = p.Record(&exportPayment{})
+	assert.Equal(t, "profile bad: field Card: unknown mask rot13", err.Error())
+
+	_, err = LoadProfiles(strings.NewReader(`[{"name": "a"}, {"name": "a"}]`))
+	assert.NotNil(t, err)
+}
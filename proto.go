@@ -0,0 +1,66 @@
+package csvutil
+
+import (
+	"reflect"
+	"strings"
+)
+
+// protoEnums maps protobuf enum type to its values by name.
+type protoEnums map[reflect.Type]map[string]int32
+
+// Proto enables decoding into protobuf generated structures.
+// The XXX_ prefixed fields are not bound to CSV columns, pointer fields and
+// well known wrapper types (*wrapperspb.Int64Value etc.) are allocated when
+// column is not empty and enum fields accept numbers or names registered
+// with ProtoEnum.
+func (r *Reader) Proto(b bool) *Reader {
+	r.proto = b
+	return r
+}
+
+// ProtoEnum registers names of the protobuf enum values so they can be decoded
+// by name. The enum is any value of the enum type.
+//
+// Example:
+//
+//	NewCsvUtil(sr).Proto(true).ProtoEnum(pb.Status(0), pb.Status_value)
+func (r *Reader) ProtoEnum(enum interface{}, values map[string]int32) *Reader {
+	if r.protoEnums == nil {
+		r.protoEnums = make(protoEnums)
+	}
+	r.protoEnums[reflect.TypeOf(enum)] = values
+	return r
+}
+
+// protoEnum returns value of enum of type t by its name.
+// Returns err if name is not known.
+func (r *Reader) protoEnum(t reflect.Type, name string, err error) (int64, error) {
+	if v, ok := r.protoEnums[t][name]; ok {
+		return int64(v), nil
+	}
+	return 0, err
+}
+
+// protoFields returns fields without protobuf internal XXX_ fields.
+func protoFields(fields []*sField) []*sField {
+	var pf []*sField
+	for _, f := range fields {
+		if !strings.HasPrefix(f.name, "XXX_") {
+			pf = append(pf, f)
+		}
+	}
+	return pf
+}
+
+// isProtoWrapper returns true if t is protobuf well known wrapper type
+// or pointer to it. Wrappers are structures with scalar Value field.
+func isProtoWrapper(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || !strings.HasSuffix(t.Name(), "Value") {
+		return false
+	}
+	f, ok := t.FieldByName("Value")
+	return ok && f.Type.Kind() != reflect.Struct && supported(f.Type)
+}
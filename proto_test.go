@@ -0,0 +1,59 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type testStatus int32
+
+// Int64Value mimics wrapperspb.Int64Value.
+type Int64Value struct {
+	state         struct{}
+	sizeCache     int32
+	unknownFields []byte
+
+	Value int64
+}
+
+type protoPerson struct {
+	XXX_NoUnkeyedLiteral struct{}
+	Name                 *string
+	Age                  *Int64Value
+	Status               testStatus
+	Nick                 *string
+	XXX_unrecognized     []byte
+	XXX_sizecache        int32
+}
+
+func Test_Proto(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("Tony,23,ACTIVE,\nJohn,,2,Jo")
+	c := NewCsvUtil(sr).Proto(true).ProtoEnum(testStatus(0), map[string]int32{"ACTIVE": 1})
+
+	// Start test
+	p := &protoPerson{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "Tony", *p.Name)
+	assert.Equal(t, int64(23), p.Age.Value)
+	assert.Equal(t, testStatus(1), p.Status)
+	assert.Equal(t, (*string)(nil), p.Nick)
+
+	p = &protoPerson{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "John", *p.Name)
+	assert.Equal(t, (*Int64Value)(nil), p.Age)
+	assert.Equal(t, testStatus(2), p.Status)
+	assert.Equal(t, "Jo", *p.Nick)
+
+	assert.Equal(t, 0, len(c.UnboundFields(p)))
+}
+
+func Test_ProtoUnknownEnum(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("Tony,23,GONE,")
+	c := NewCsvUtil(sr).Proto(true)
+
+	// Start test
+	assert.NotNil(t, c.SetData(&protoPerson{}))
+}
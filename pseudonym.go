@@ -0,0 +1,83 @@
+package csvutil
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sync"
+)
+
+// PseudonymEncoding selects how pseudonyms are encoded.
+type PseudonymEncoding int
+
+// Supported pseudonym encodings.
+const (
+	PseudonymHex    PseudonymEncoding = iota // Lower case hex
+	PseudonymBase64                          // URL safe base64 without padding
+	PseudonymBase32                          // Base32 without padding
+)
+
+// ErrNoPseudonymizer is returned when pseudonymized field is written without registered Pseudonymizer.
+var ErrNoPseudonymizer = errors.New("no pseudonymizer registered for pseudonymized field")
+
+// Pseudonymizer replaces identifiers with their keyed HMAC-SHA256 hashes.
+// The same value always gets the same pseudonym for given key so exported
+// files can still be joined on pseudonymized columns.
+type Pseudonymizer struct {
+	key []byte
+	enc PseudonymEncoding
+}
+
+// Pseudonymizer used by ToCsv.
+var (
+	pseudonymizerMu    sync.RWMutex
+	fieldPseudonymizer *Pseudonymizer
+)
+
+// NewPseudonymizer returns Pseudonymizer using the secret key.
+func NewPseudonymizer(key []byte, enc PseudonymEncoding) *Pseudonymizer {
+	return &Pseudonymizer{key: key, enc: enc}
+}
+
+// RegisterPseudonymizer sets Pseudonymizer used by ToCsv for fields tagged
+// with pseudonymize option.
+//
+// Example:
+//
+//	type user struct {
+//		Email string `csv:"email,pseudonymize"`
+//	}
+func RegisterPseudonymizer(p *Pseudonymizer) {
+	pseudonymizerMu.Lock()
+	defer pseudonymizerMu.Unlock()
+	fieldPseudonymizer = p
+}
+
+// pseudonymize returns pseudonym of the value using registered Pseudonymizer.
+func pseudonymize(value string) (string, error) {
+	pseudonymizerMu.RLock()
+	p := fieldPseudonymizer
+	pseudonymizerMu.RUnlock()
+	if p == nil {
+		return "", ErrNoPseudonymizer
+	}
+	return p.Pseudonym(value), nil
+}
+
+// Pseudonym returns pseudonym of the value.
+func (p *Pseudonymizer) Pseudonym(value string) string {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(value))
+	sum := mac.Sum(nil)
+
+	switch p.enc {
+	case PseudonymBase64:
+		return base64.RawURLEncoding.EncodeToString(sum)
+	case PseudonymBase32:
+		return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(sum)
+	}
+	return hex.EncodeToString(sum)
+}
@@ -0,0 +1,34 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type pseudoUser struct {
+	Email string `csv:"email,pseudonymize"`
+	Plan  string
+}
+
+func Test_Pseudonymizer(t *testing.T) {
+	// Prepare test
+	p := NewPseudonymizer([]byte("key"), PseudonymHex)
+
+	// Start test
+	assert.Equal(t, p.Pseudonym("a@b.c"), p.Pseudonym("a@b.c"))
+	assert.Equal(t, 64, len(p.Pseudonym("a@b.c")))
+	assert.Equal(t, false, p.Pseudonym("a@b.c") == NewPseudonymizer([]byte("other"), PseudonymHex).Pseudonym("a@b.c"))
+	assert.Equal(t, 43, len(NewPseudonymizer([]byte("key"), PseudonymBase64).Pseudonym("a@b.c")))
+	assert.Equal(t, 52, len(NewPseudonymizer([]byte("key"), PseudonymBase32).Pseudonym("a@b.c")))
+}
+
+func Test_ToCsvPseudonymize(t *testing.T) {
+	// Prepare test
+	p := NewPseudonymizer([]byte("key"), PseudonymHex)
+	RegisterPseudonymizer(p)
+	defer RegisterPseudonymizer(nil)
+
+	// Start test
+	assert.Equal(t, p.Pseudonym("a@b.c")+",pro", ToCsv(&pseudoUser{Email: "a@b.c", Plan: "pro"}, ",", "T", "F"))
+	assert.Equal(t, ",pro", ToCsv(&pseudoUser{Plan: "pro"}, ",", "T", "F"))
+}
@@ -0,0 +1,69 @@
+package csvutil
+
+import (
+	"io"
+	"reflect"
+)
+
+// ReadAll decodes all remaining records and appends them to the slice
+// pointed to by v. Slice elements may be structures or pointers to them.
+// Reading stops at the first error, records decoded before it are kept.
+//
+// Example:
+//
+//	var people []person
+//	err := c.ReadAll(&people)
+func (r *Reader) ReadAll(v interface{}) error {
+	return r.ReadAllCap(v, 0)
+}
+
+// ReadAllCap works like ReadAll but makes room for expectedRows records
+// upfront to avoid reallocations of the slice while it grows.
+func (r *Reader) ReadAllCap(v interface{}, expectedRows int) error {
+	ptr := reflect.ValueOf(v)
+	if ptr.Kind() != reflect.Ptr || ptr.Elem().Kind() != reflect.Slice {
+		panic("Expected pointer to a slice")
+	}
+	slice := ptr.Elem()
+	elemType := slice.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Ptr
+	if isPtr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		panic("Expected pointer to a slice of structs")
+	}
+
+	if free := slice.Cap() - slice.Len(); expectedRows > free {
+		grown := reflect.MakeSlice(slice.Type(), slice.Len(), slice.Len()+expectedRows)
+		reflect.Copy(grown, slice)
+		slice.Set(grown)
+	}
+
+	// Records are not kept after they are decoded so CSV reader can reuse
+	// memory of the previous one.
+	reuse := r.csvr.ReuseRecord
+	r.csvr.ReuseRecord = true
+	defer func() { r.csvr.ReuseRecord = reuse }()
+
+	for {
+		n := slice.Len()
+		if isPtr {
+			slice.Set(reflect.Append(slice, reflect.New(elemType)))
+		} else {
+			slice.Set(reflect.Append(slice, reflect.Zero(elemType)))
+		}
+
+		elem := slice.Index(n)
+		if !isPtr {
+			elem = elem.Addr()
+		}
+		if err := r.SetData(elem.Interface()); err != nil {
+			slice.Set(slice.Slice(0, n))
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type bulkRow struct {
+	Name string
+	Age  int
+}
+
+func Test_ReadAll(t *testing.T) {
+	// Prepare test
+	data := "Tony,23\nJohn,34\n"
+
+	// Start test
+	var rows []bulkRow
+	assert.NotError(t, FromString(data).ReadAllCap(&rows, 10))
+	assert.Equal(t, []bulkRow{{"Tony", 23}, {"John", 34}}, rows)
+	assert.Equal(t, 10, cap(rows))
+
+	var ptrs []*bulkRow
+	assert.NotError(t, FromString(data).ReadAll(&ptrs))
+	assert.Equal(t, 2, len(ptrs))
+	assert.Equal(t, bulkRow{"John", 34}, *ptrs[1])
+}
+
+func Test_ReadAllError(t *testing.T) {
+	// Prepare test
+	var rows []bulkRow
+
+	// Start test
+	err := FromString("Tony,23\nJohn,x\nAnna,5\n").ReadAll(&rows)
+	assert.NotNil(t, err)
+	assert.Equal(t, []bulkRow{{"Tony", 23}}, rows)
+	assert.Panic(t, func() { FromString("").ReadAll(rows) }, "Expected panic for non pointer")
+}
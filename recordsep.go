@@ -0,0 +1,58 @@
+package csvutil
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// RecordSeparator sets the string records are separated by instead of the
+// new line, e.g. "\x1e" (ASCII record separator) or ";\n". Line breaks
+// still end records unless they are quoted, the separator ends records
+// even in quoted values. Input offsets reported by RowMeta and Checkpoint
+// count every separator as one byte. Must be called before the first
+// record is read.
+func (r *Reader) RecordSeparator(sep string) *Reader {
+	if sep == "" {
+		panic("csvutil: empty record separator")
+	}
+	r.wrap(func(src io.Reader) io.Reader {
+		return &separatorReader{br: bufio.NewReader(src), sep: []byte(sep)}
+	})
+	return r
+}
+
+// separatorReader reads from br replacing sep with new lines.
+type separatorReader struct {
+	br  *bufio.Reader
+	sep []byte
+}
+
+func (s *separatorReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	n := s.br.Buffered()
+	if n < len(s.sep) {
+		n = len(s.sep)
+	}
+	buf, err := s.br.Peek(n)
+	if len(buf) == 0 {
+		return 0, err
+	}
+
+	switch i := bytes.Index(buf, s.sep); {
+	case i == 0:
+		p[0] = '\n'
+		s.br.Discard(len(s.sep))
+		return 1, nil
+	case i > 0:
+		buf = buf[:i]
+	case err == nil:
+		// The separator may start in the last bytes.
+		buf = buf[:len(buf)-len(s.sep)+1]
+	}
+	n = copy(p, buf)
+	s.br.Discard(n)
+	return n, nil
+}
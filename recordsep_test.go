@@ -0,0 +1,30 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func Test_RecordSeparator(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,23\x1eJohn,\"34\nyears\"\x1e").RecordSeparator("\x1e")
+
+	// Start test
+	records, err := c.Head(5)
+	assert.NotError(t, err)
+	assert.Equal(t, [][]string{{"Tony", "23"}, {"John", "34\nyears"}}, records)
+}
+
+func Test_RecordSeparatorSplit(t *testing.T) {
+	// Prepare test
+	c := NewCsvUtil(iotest.OneByteReader(strings.NewReader("Tony;23;\nJohn;34;\nAnn;1"))).Comma(';').RecordSeparator(";\n")
+
+	// Start test
+	records, err := c.Head(5)
+	assert.NotError(t, err)
+	assert.Equal(t, [][]string{{"Tony", "23"}, {"John", "34"}, {"Ann", "1"}}, records)
+
+	assert.Panic(t, func() { FromString("").RecordSeparator("") }, "csvutil: empty record separator")
+}
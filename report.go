@@ -0,0 +1,112 @@
+package csvutil
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReportSamples is the maximum number of sample violations included in
+// JSON report in total and for each column.
+var ReportSamples = 10
+
+// WriteCSV writes violations to w as CSV file with one row per problem so
+// they can be reviewed in a spreadsheet. The first row is the header:
+// line, column, value, error, suggestion. Cells which spreadsheets would
+// evaluate as formulas are prefixed with apostrophe.
+func (vr *ValidationReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"line", "column", "value", "error", "suggestion"}); err != nil {
+		return err
+	}
+	for _, v := range vr.Violations {
+		record := []string{strconv.Itoa(v.Line), noFormula(v.Column), noFormula(v.Value), noFormula(v.Message), noFormula(v.Suggestion)}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// noFormula prefixes value starting like spreadsheet formula with apostrophe
+// so values from untrusted files are never evaluated.
+func noFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// reportJSON is the schema of ValidationReport.JSON.
+type reportJSON struct {
+	Valid      bool               `json:"valid"`
+	Rows       int                `json:"rows"`
+	Violations int                `json:"violations"`
+	Samples    []violationJSON    `json:"samples"`
+	Columns    []columnReportJSON `json:"columns"`
+}
+
+// columnReportJSON summarizes violations of a single column.
+type columnReportJSON struct {
+	Column     string          `json:"column"`
+	Violations int             `json:"violations"`
+	Samples    []violationJSON `json:"samples"`
+}
+
+// violationJSON is a single violation in JSON report.
+type violationJSON struct {
+	Line       int    `json:"line"`
+	Column     string `json:"column"`
+	Value      string `json:"value"`
+	Error      string `json:"error"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// JSON returns the report as JSON document meant for upload APIs returning
+// structured feedback. At most ReportSamples violations are included as
+// samples, in total and for each column. Columns are listed in the order
+// of their first violation.
+//
+// Example:
+//
+//	{
+//		"valid": false,
+//		"rows": 3,
+//		"violations": 1,
+//		"samples": [{"line": 3, "column": "Age", "value": "x", "error": "expected int"}],
+//		"columns": [{"column": "Age", "violations": 1, "samples": [...]}]
+//	}
+func (vr *ValidationReport) JSON() ([]byte, error) {
+	rep := reportJSON{
+		Valid:      vr.Valid(),
+		Rows:       vr.Rows,
+		Violations: len(vr.Violations),
+		Samples:    []violationJSON{},
+		Columns:    []columnReportJSON{},
+	}
+
+	byColumn := make(map[string]int)
+	for _, v := range vr.Violations {
+		vj := violationJSON{Line: v.Line, Column: v.Column, Value: v.Value, Error: v.Message, Suggestion: v.Suggestion}
+		if len(rep.Samples) < ReportSamples {
+			rep.Samples = append(rep.Samples, vj)
+		}
+
+		i, ok := byColumn[v.Column]
+		if !ok {
+			i = len(rep.Columns)
+			byColumn[v.Column] = i
+			rep.Columns = append(rep.Columns, columnReportJSON{Column: v.Column, Samples: []violationJSON{}})
+		}
+		col := &rep.Columns[i]
+		col.Violations++
+		if len(col.Samples) < ReportSamples {
+			col.Samples = append(col.Samples, vj)
+		}
+	}
+
+	return json.Marshal(rep)
+}
@@ -0,0 +1,45 @@
+package csvutil
+
+import (
+	"bytes"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_ValidationReportWriteCSV(t *testing.T) {
+	// Prepare test
+	vr := &ValidationReport{Rows: 3, Violations: []Violation{
+		{Line: 1, Column: "Email", Message: "column is missing"},
+		{Line: 3, Column: "Age", Value: "1,5", Message: "expected int", Suggestion: "2"},
+		{Line: 4, Column: "Age", Value: "=1+2", Message: "expected int"},
+		{Line: 5, Column: "Age", Value: "-5", Message: "must be positive", Suggestion: "@SUM(A1)"},
+	}}
+	var buf bytes.Buffer
+
+	// Start test
+	assert.NotError(t, vr.WriteCSV(&buf))
+	assert.Equal(t, "line,column,value,error,suggestion\n1,Email,,column is missing,\n3,Age,\"1,5\",expected int,2\n4,Age,'=1+2,expected int,\n5,Age,'-5,must be positive,'@SUM(A1)\n", buf.String())
+}
+
+func Test_ValidationReportJSON(t *testing.T) {
+	// Prepare test
+	defer func(n int) { ReportSamples = n }(ReportSamples)
+	ReportSamples = 1
+	vr := &ValidationReport{Rows: 3, Violations: []Violation{
+		{Line: 2, Column: "Age", Value: "x", Message: "expected int"},
+		{Line: 3, Column: "Name", Message: "value is required"},
+		{Line: 3, Column: "Age", Value: "y", Message: "expected int", Suggestion: "0"},
+	}}
+
+	// Start test
+	data, err := vr.JSON()
+	assert.NotError(t, err)
+	assert.Equal(t, `{"valid":false,"rows":3,"violations":3,`+
+		`"samples":[{"line":2,"column":"Age","value":"x","error":"expected int"}],`+
+		`"columns":[{"column":"Age","violations":2,"samples":[{"line":2,"column":"Age","value":"x","error":"expected int"}]},`+
+		`{"column":"Name","violations":1,"samples":[{"line":3,"column":"Name","value":"","error":"value is required"}]}]}`, string(data))
+
+	data, err = (&ValidationReport{Rows: 1}).JSON()
+	assert.NotError(t, err)
+	assert.Equal(t, `{"valid":true,"rows":1,"violations":0,"samples":[],"columns":[]}`, string(data))
+}
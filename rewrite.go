@@ -0,0 +1,189 @@
+package csvutil
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"io"
+	"reflect"
+)
+
+var textMarshalerType = reflect.TypeOf(new(encoding.TextMarshaler)).Elem()
+
+// HeaderFromFirstRow makes SetData use the first record as CSV header.
+// Columns are matched with structure fields by name and may be in any order.
+func (r *Reader) HeaderFromFirstRow() *Reader {
+	r.headerRow = true
+	return r
+}
+
+// readHeader reads the first record and sets the header from it. With
+// NumberedHeader there is no header record and nothing is read.
+func (r *Reader) readHeader() error {
+	if r.numbered {
+		return nil
+	}
+	record, err := r.read()
+	if err != nil {
+		return err
+	}
+	r.setColumns(record)
+	return nil
+}
+
+// setColumns sets column names and the header derived from them.
+func (r *Reader) setColumns(names []string) {
+	r.columns = append([]string(nil), names...)
+	h := make(CsvHeader, len(names))
+	for i, name := range names {
+		if tr, ok := r.translations[name]; ok {
+			name = tr
+		}
+		if _, ok := h[name]; !ok {
+			h[name] = i
+		}
+	}
+	r.Header(h)
+}
+
+// Columns returns column names in the order they appear in CSV header.
+// Returns nil if header has not been read from the file.
+func (r *Reader) Columns() []string {
+	return r.columns
+}
+
+// Rewrite returns the most recent CSV record with columns mapped to
+// structure fields replaced by current values of v. Columns not mapped to
+// the structure and columns whose values did not change are passed through
+// unchanged so the record can be written back without data loss.
+func (r *Reader) Rewrite(v interface{}) ([]string, error) {
+	if r.csvLine == nil {
+		return nil, errors.New("no record has been read")
+	}
+
+	record := append([]string(nil), r.csvLine...)
+	value := reflect.ValueOf(v).Elem()
+	structFields, _ := getFields(v)
+
+	// Changes made decoding the record have been noted by SetData already.
+	audit := r.audit
+	r.audit = false
+	defer func() { r.audit = audit }()
+
+	for _, sf := range structFields {
+		idx, ok := r.header[sf.col]
+		// Fields set with setter methods can't be read back.
+		if !ok || idx >= len(record) || sf.setter != "" {
+			continue
+		}
+		field := value.FieldByName(sf.name)
+
+		// Keep the original text if it still decodes to the current value.
+		orig := reflect.New(sf.typ).Elem()
+		if str, err := r.colValue(sf); err == nil && r.decode(orig, sf.name, str) == nil {
+			if spec, ok := sf.opts.get("unit"); ok {
+				applyUnit(orig, spec)
+			}
+			if reflect.DeepEqual(orig.Interface(), field.Interface()) {
+				continue
+			}
+		}
+
+		str, err := formatValue(field)
+		if err != nil {
+			return nil, err
+		}
+		if str, err = outValue(sf.col, sf.opts, str, false, nil); err != nil {
+			return nil, err
+		}
+		record[idx] = str
+	}
+
+	return record, nil
+}
+
+// decode sets value decoded from CSV column on settable elem.
+func (r *Reader) decode(elem reflect.Value, name, value string) error {
+	if reflect.PtrTo(elem.Type()).Implements(textUnmarshalerType) {
+		return unmarshalText(elem.Addr().Interface().(encoding.TextUnmarshaler), value)
+	}
+	return r.set(elem, name, value)
+}
+
+// formatValue returns string representation of the structure field.
+func formatValue(field reflect.Value) (string, error) {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return "", nil
+		}
+		field = field.Elem()
+	}
+	if field.Type().Implements(textMarshalerType) {
+		b, err := field.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	if field.CanAddr() && reflect.PtrTo(field.Type()).Implements(textMarshalerType) {
+		b, err := field.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	if !supported(field.Type()) {
+		return "", errors.New("unsupported field type " + field.Type().String())
+	}
+	return getValue(field, "true", "false"), nil
+}
+
+// Process streams CSV records from in through fn and writes them to out
+// with the same header. The v is pointer to the structure each record is
+// decoded into, fn may modify it and decides if the record is kept.
+// Columns not mapped to the structure are written unchanged.
+//
+// Example:
+//
+//	p := &person{}
+//	err := csvutil.Process(in, out, p, func(v interface{}) (bool, error) {
+//		p.Name = strings.TrimSpace(p.Name)
+//		return p.Age > 0, nil
+//	})
+func Process(in io.Reader, out io.Writer, v interface{}, fn func(v interface{}) (keep bool, err error)) error {
+	r := NewCsvUtil(in).OwnsSource(false).HeaderFromFirstRow()
+	w := csv.NewWriter(out)
+
+	if err := r.readHeader(); err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+	if err := w.Write(r.columns); err != nil {
+		return err
+	}
+
+	for {
+		err := r.SetData(v)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		keep, err := fn(v)
+		if err != nil {
+			return &RowError{Row: r.row, Err: err}
+		}
+		if !keep {
+			continue
+		}
+
+		record, err := r.Rewrite(v)
+		if err != nil {
+			return err
+		}
+		if err = w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
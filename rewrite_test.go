@@ -0,0 +1,98 @@
+package csvutil
+
+import (
+	"bytes"
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+func Test_HeaderFromFirstRow(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("Extra,Balance,Name\nx,1.50,Tony\ny,2,John")
+	c := NewCsvUtil(sr).HeaderFromFirstRow()
+
+	// Start test
+	p := &person2{}
+	assert.Equal(t, []string(nil), c.Columns())
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person2{Name: "Tony", Balance: 1.5}, *p)
+	assert.Equal(t, []string{"Extra", "Balance", "Name"}, c.Columns())
+
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person2{Name: "John", Balance: 2}, *p)
+}
+
+func Test_Rewrite(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("Extra,Balance,Name\nx,1.50,Tony\ny,2.00,John")
+	c := NewCsvUtil(sr).HeaderFromFirstRow()
+
+	// Start test
+	_, err := c.Rewrite(&person2{})
+	assert.NotNil(t, err)
+
+	p := &person2{}
+	assert.NotError(t, c.SetData(p))
+	record, err := c.Rewrite(p)
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"x", "1.50", "Tony"}, record)
+
+	assert.NotError(t, c.SetData(p))
+	p.Name = "Johnny"
+	p.Balance = 2.25
+	record, err = c.Rewrite(p)
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"y", "2.25", "Johnny"}, record)
+}
+
+func Test_RewriteAudit(t *testing.T) {
+	// Prepare test
+	c := FromString("Balance,Name\n1.50, Tony \n").HeaderFromFirstRow().Whitespace(WhitespaceTrimAll).Audit(true)
+	p := &person2{}
+
+	// Start test
+	assert.NotError(t, c.SetData(p))
+	changes := append([]Change(nil), c.Changes()...)
+	assert.Equal(t, 1, len(changes))
+	_, err := c.Rewrite(p)
+	assert.NotError(t, err)
+	assert.Equal(t, changes, c.Changes())
+}
+
+func Test_Process(t *testing.T) {
+	// Prepare test
+	in := strings.NewReader("Extra,Balance,Name\n\"x,y\",1.50,Tony\ny,2.00,John\nz,3,Mark\n")
+	var out bytes.Buffer
+
+	// Start test
+	p := &person2{}
+	err := Process(in, &out, p, func(v interface{}) (bool, error) {
+		p.Name = strings.ToUpper(p.Name)
+		return p.Balance != 2, nil
+	})
+	assert.NotError(t, err)
+	assert.Equal(t, "Extra,Balance,Name\n\"x,y\",1.50,TONY\nz,3,MARK\n", out.String())
+}
+
+func Test_ProcessHeaderOnly(t *testing.T) {
+	// Prepare test
+	var out bytes.Buffer
+
+	// Start test
+	err := Process(strings.NewReader("Balance,Name\n"), &out, &person2{}, func(v interface{}) (bool, error) {
+		return true, nil
+	})
+	assert.NotError(t, err)
+	assert.Equal(t, "Balance,Name\n", out.String())
+}
+
+func Test_ProcessError(t *testing.T) {
+	// Start test
+	err := Process(strings.NewReader("Balance,Name\n1,Tony\n"), io.Discard, &person2{}, func(v interface{}) (bool, error) {
+		return false, errors.New("boom")
+	})
+	assert.Equal(t, &RowError{Row: 2, Err: errors.New("boom")}, err)
+}
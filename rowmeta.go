@@ -0,0 +1,68 @@
+package csvutil
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"strconv"
+)
+
+// RowMeta describes origin of the record. When embedded in the structure
+// passed to SetData it's filled automatically. RowMeta is not a part of the
+// record, ToCsv, Export and Headers skip it.
+//
+// Example:
+//
+//	type person struct {
+//		csvutil.RowMeta
+//		Name string
+//		Age  int
+//	}
+type RowMeta struct {
+	Line   int    // Line the record starts at
+	Offset int64  // Input offset where reading of the record started, comments preceding the record are included
+	Source string // Input name set with Reader.Source
+	Hash   string // Hex encoded SHA-256 of the length prefixed record fields
+	Key    string // Idempotency key, see Reader.IdempotencyKey
+}
+
+var rowMetaType = reflect.TypeOf(RowMeta{})
+
+// isRowMeta returns true if f holds RowMeta and must not be written.
+func isRowMeta(f reflect.StructField) bool {
+	return f.Type == rowMetaType
+}
+
+// Source sets the input name reported in RowMeta, e.g. the file name.
+func (r *Reader) Source(name string) *Reader {
+	r.source = name
+	return r
+}
+
+// setRowMeta fills RowMeta embedded in the structure v.
+func (r *Reader) setRowMeta(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.Anonymous && f.Type == rowMetaType {
+			line, _ := r.fieldPos(0)
+			v.Field(i).Set(reflect.ValueOf(RowMeta{
+				Line:   line,
+				Offset: r.offset,
+				Source: r.source,
+				Hash:   valuesHash(r.csvLine),
+				Key:    r.key,
+			}))
+			return
+		}
+	}
+}
+
+// valuesHash returns hex encoded SHA-256 of the values. Values are length
+// prefixed so moving characters between them changes the hash.
+func valuesHash(values []string) string {
+	h := sha256.New()
+	for _, v := range values {
+		h.Write([]byte(strconv.Itoa(len(v)) + ":" + v))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,69 @@
+package csvutil
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type metaPerson struct {
+	RowMeta
+	Name string
+	Age  int
+}
+
+func Test_RowMeta(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("Tony,23\n# comment\n\"John\nSmith\",34")
+	c := NewCsvUtil(sr).Comment('#').Source("people.csv")
+
+	// Start test
+	p := &metaPerson{}
+	assert.NotError(t, c.SetData(p))
+	sum := sha256.Sum256([]byte("4:Tony2:23"))
+	assert.Equal(t, RowMeta{Line: 1, Offset: 0, Source: "people.csv", Hash: hex.EncodeToString(sum[:])}, p.RowMeta)
+	assert.Equal(t, "Tony", p.Name)
+
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, 3, p.Line)
+	assert.Equal(t, int64(8), p.Offset) // Comment line belongs to the record
+	assert.Equal(t, "John\nSmith", p.Name)
+
+	assert.Equal(t, 0, len(c.UnboundFields(p)))
+}
+
+func Test_RowMetaHashQuoted(t *testing.T) {
+	// Prepare test
+	type pair struct {
+		RowMeta
+		A string
+		B string
+	}
+	sr := NewStringReadCloser("\"a,b\",c\na,\"b,c\"")
+	c := NewCsvUtil(sr)
+
+	// Start test
+	p1, p2 := &pair{}, &pair{}
+	assert.NotError(t, c.SetData(p1))
+	assert.NotError(t, c.SetData(p2))
+	assert.Equal(t, false, p1.Hash == p2.Hash)
+}
+
+func Test_RowMetaNotWritten(t *testing.T) {
+	// Prepare test
+	p := &metaPerson{RowMeta: RowMeta{Line: 3, Source: "people.csv"}, Name: "Tony", Age: 23}
+	var buf bytes.Buffer
+
+	// Start test
+	assert.Equal(t, "Tony,23", ToCsv(p, ",", "T", "F"))
+
+	names, err := Headers(p)
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"Name", "Age"}, names)
+
+	_, err = NewExport([]*metaPerson{p}, Dialect{}).Header(true).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "Name,Age\nTony,23\n", buf.String())
+}
@@ -0,0 +1,115 @@
+package csvutil
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// FormatRule checks the column value is in the expected format. Empty
+// values are not checked, use minlen=1 to require the value.
+type FormatRule func(value string) error
+
+// formatRules maps names of format rules to their checks.
+var (
+	formatRulesMu sync.RWMutex
+	formatRules   = map[string]FormatRule{
+		"email":    matchRule(regexp.MustCompile(`^[^@\s]+@[^@\s.]+(\.[^@\s.]+)+$`), "not a valid email"),
+		"e164":     matchRule(regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`), "not an E.164 phone number"),
+		"uuid":     matchRule(regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`), "not a valid UUID"),
+		"country":  setRule(isoCountries, "not an ISO 3166-1 country code"),
+		"currency": setRule(isoCurrencies, "not an ISO 4217 currency code"),
+		"url":      checkURL,
+	}
+)
+
+// RegisterRule registers format rule under the name so it can be used
+// in validate tag.
+//
+// Example:
+//
+//	csvutil.RegisterRule("sku", func(value string) error { ... })
+//
+//	type product struct {
+//		SKU string `validate:"sku"`
+//	}
+func RegisterRule(name string, fn FormatRule) {
+	formatRulesMu.Lock()
+	defer formatRulesMu.Unlock()
+	formatRules[name] = fn
+}
+
+// formatRule returns format rule registered under the name or nil.
+func formatRule(name string) FormatRule {
+	formatRulesMu.RLock()
+	defer formatRulesMu.RUnlock()
+	return formatRules[name]
+}
+
+// isRule returns true if name is a name of validation rule.
+func isRule(name string) bool {
+	return ruleNames[name] || formatRule(name) != nil
+}
+
+// matchRule returns format rule accepting values matching re.
+func matchRule(re *regexp.Regexp, msg string) FormatRule {
+	return func(value string) error {
+		if !re.MatchString(value) {
+			return errors.New(msg)
+		}
+		return nil
+	}
+}
+
+// setRule returns format rule accepting values from the space separated list.
+func setRule(list, msg string) FormatRule {
+	set := make(map[string]struct{})
+	for _, v := range strings.Fields(list) {
+		set[v] = struct{}{}
+	}
+	return func(value string) error {
+		if _, ok := set[value]; !ok {
+			return errors.New(msg)
+		}
+		return nil
+	}
+}
+
+// checkURL accepts absolute URLs.
+func checkURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.New("not a valid URL")
+	}
+	return nil
+}
+
+// ISO 3166-1 alpha-2 country codes.
+const isoCountries = `
+AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ
+BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR
+CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR
+GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU
+ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ
+LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ
+MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF
+PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI
+SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR
+TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW
+`
+
+// ISO 4217 currency codes.
+const isoCurrencies = `
+AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB
+BOV BRL BSD BTN BWP BYN BZD CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUC
+CUP CVE CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF
+GTQ GYD HKD HNL HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF
+KPW KRW KWD KYD KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU
+MUR MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR
+PLN PYG QAR RON RSD RUB RWF SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP
+STN SVC SYP SZL THB TJS TMT TND TOP TRY TTD TWD TZS UAH UGX USD USN UYI UYU
+UYW UZS VED VES VND VUV WST XAF XAG XAU XBA XBB XBC XBD XCD XDR XOF XPD XPF
+XPT XSU XTS XUA XXX YER ZAR ZMW ZWL
+`
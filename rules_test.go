@@ -0,0 +1,86 @@
+package csvutil
+
+import (
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type contact struct {
+	Email    string `validate:"minlen=1,email"`
+	Phone    string `validate:"e164"`
+	Country  string `validate:"country"`
+	Currency string `validate:"currency"`
+	Site     string `validate:"url"`
+	ID       string `validate:"uuid"`
+}
+
+func Test_FormatRules(t *testing.T) {
+	assert.NotError(t, formatRules["email"]("tony@example.com"))
+	assert.NotNil(t, formatRules["email"]("tony@localhost"))
+	assert.NotNil(t, formatRules["email"]("tony example.com"))
+	assert.NotError(t, formatRules["e164"]("+48601234567"))
+	assert.NotNil(t, formatRules["e164"]("601234567"))
+	assert.NotError(t, formatRules["country"]("PL"))
+	assert.NotNil(t, formatRules["country"]("pl"))
+	assert.NotError(t, formatRules["currency"]("EUR"))
+	assert.NotNil(t, formatRules["currency"]("EURO"))
+	assert.NotError(t, formatRules["url"]("https://example.com/a?b=c"))
+	assert.NotNil(t, formatRules["url"]("example.com"))
+	assert.NotError(t, formatRules["uuid"]("123e4567-e89b-12d3-a456-426614174000"))
+	assert.NotNil(t, formatRules["uuid"]("123e4567e89b12d3a456426614174000"))
+}
+
+func Test_SetDataFormatRules(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("t@x.com,+48601234567,PL,PLN,http://x.com,123e4567-e89b-12d3-a456-426614174000\nt@x.com,,,,,\n,,,,,\nt@x.com,,XX,,,")
+	c := NewCsvUtil(sr)
+
+	// Start test
+	v := &contact{}
+	assert.NotError(t, c.SetData(v))
+	assert.Equal(t, "PLN", v.Currency)
+	assert.NotError(t, c.SetData(v))
+
+	err := c.SetData(v)
+	assert.Equal(t, "line 3, column Email: shorter than 1", err.Error())
+
+	err = c.SetData(v)
+	assert.Equal(t, &FieldError{Line: 4, Column: "Country", Value: "XX", Err: errors.New("not an ISO 3166-1 country code")}, err)
+}
+
+func Test_RegisterRule(t *testing.T) {
+	// Prepare test
+	RegisterRule("even", func(value string) error {
+		if len(value)%2 != 0 {
+			return errors.New("odd length")
+		}
+		return nil
+	})
+
+	// Start test
+	rules, err := parseRules("regex=^a,b$,even")
+	assert.NotError(t, err)
+	assert.Equal(t, 2, len(rules))
+	assert.NotNil(t, rules[1].check("abc"))
+
+	_, err = parseRules("email=1")
+	assert.NotNil(t, err)
+}
+
+func Test_RegisterRuleConcurrent(t *testing.T) {
+	// Prepare test
+	done := make(chan struct{})
+
+	// Start test
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			parseRules("email,even")
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		RegisterRule("even", func(value string) error { return nil })
+	}
+	<-done
+}
@@ -0,0 +1,99 @@
+package csvutil
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"sort"
+)
+
+// Head returns at most n next records.
+func (r *Reader) Head(n int) ([][]string, error) {
+	var records [][]string
+	if n <= 0 {
+		return records, nil
+	}
+	err := r.each(func(record []string) bool {
+		records = append(records, record)
+		return len(records) < n
+	})
+	return records, err
+}
+
+// Sample returns n records chosen uniformly at random from all remaining
+// records using reservoir sampling. The same seed gives the same sample.
+// Records are returned in the order they appear in the file.
+func (r *Reader) Sample(n int, seed int64) ([][]string, error) {
+	type sampled struct {
+		row    int // Position of the record in the file
+		record []string
+	}
+	rnd := rand.New(rand.NewSource(seed))
+	var reservoir []sampled
+	seen := 0
+
+	err := r.each(func(record []string) bool {
+		if seen < n {
+			reservoir = append(reservoir, sampled{row: seen, record: record})
+		} else if j := rnd.Intn(seen + 1); j < n {
+			reservoir[j] = sampled{row: seen, record: record}
+		}
+		seen++
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore the file order.
+	sort.Slice(reservoir, func(i, j int) bool { return reservoir[i].row < reservoir[j].row })
+	var records [][]string
+	for _, s := range reservoir {
+		records = append(records, s.record)
+	}
+	return records, nil
+}
+
+// EveryNth returns every k-th of the remaining records starting with the first one.
+// Returns an error if k is not positive.
+func (r *Reader) EveryNth(k int) ([][]string, error) {
+	if k < 1 {
+		return nil, errors.New("sampling interval must be positive")
+	}
+	var records [][]string
+	i := 0
+	err := r.each(func(record []string) bool {
+		if i%k == 0 {
+			records = append(records, record)
+		}
+		i++
+		return true
+	})
+	return records, err
+}
+
+// each calls fn for the remaining data records until it returns false.
+// The header record is skipped when HeaderFromFirstRow is set.
+func (r *Reader) each(fn func(record []string) bool) error {
+	if r.headerRow && r.columns == nil {
+		if err := r.readHeader(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+
+	for {
+		record, err := r.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !fn(record) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// numbered returns reader of CSV with header and n numbered records.
+func numbered(n int) *Reader {
+	lines := []string{"N"}
+	for i := 0; i < n; i++ {
+		lines = append(lines, strconv.Itoa(i))
+	}
+	return NewCsvUtil(NewStringReadCloser(strings.Join(lines, "\n"))).HeaderFromFirstRow()
+}
+
+func Test_Head(t *testing.T) {
+	// Start test
+	c := numbered(5)
+	records, err := c.Head(2)
+	assert.NotError(t, err)
+	assert.Equal(t, [][]string{{"0"}, {"1"}}, records)
+
+	records, err = c.Head(0)
+	assert.NotError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	records, err = c.Head(10)
+	assert.NotError(t, err)
+	assert.Equal(t, [][]string{{"2"}, {"3"}, {"4"}}, records)
+
+	records, err = c.Head(0)
+	assert.NotError(t, err)
+	assert.Equal(t, 0, len(records))
+}
+
+func Test_Sample(t *testing.T) {
+	// Start test
+	s1, err := numbered(100).Sample(5, 42)
+	assert.NotError(t, err)
+	assert.Equal(t, 5, len(s1))
+
+	s2, err := numbered(100).Sample(5, 42)
+	assert.NotError(t, err)
+	assert.Equal(t, s1, s2)
+
+	for i := 1; i < len(s1); i++ {
+		a, _ := strconv.Atoi(s1[i-1][0])
+		b, _ := strconv.Atoi(s1[i][0])
+		assert.Equal(t, true, a < b)
+	}
+
+	s3, err := numbered(3).Sample(5, 42)
+	assert.NotError(t, err)
+	assert.Equal(t, [][]string{{"0"}, {"1"}, {"2"}}, s3)
+}
+
+func Test_EveryNth(t *testing.T) {
+	// Start test
+	records, err := numbered(7).EveryNth(3)
+	assert.NotError(t, err)
+	assert.Equal(t, [][]string{{"0"}, {"3"}, {"6"}}, records)
+
+	_, err = numbered(7).EveryNth(0)
+	assert.NotNil(t, err)
+	_, err = numbered(7).EveryNth(-1)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,207 @@
+package csvutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// Column types understood by Schema.
+const (
+	TypeString = "string"
+	TypeInt    = "int"
+	TypeFloat  = "float"
+	TypeBool   = "bool"
+)
+
+// Column describes constraints of a single CSV column.
+type Column struct {
+	Name     string   `json:"name" yaml:"name"`
+	Type     string   `json:"type,omitempty" yaml:"type,omitempty"`         // One of Type* constants, TypeString when empty
+	Required bool     `json:"required,omitempty" yaml:"required,omitempty"` // Value must not be empty
+	Pattern  string   `json:"pattern,omitempty" yaml:"pattern,omitempty"`   // Regular expression value must match
+	Min      *float64 `json:"min,omitempty" yaml:"min,omitempty"`           // Minimum of numeric value
+	Max      *float64 `json:"max,omitempty" yaml:"max,omitempty"`           // Maximum of numeric value
+	Enum     []string `json:"enum,omitempty" yaml:"enum,omitempty"`         // Allowed values
+
+	re *regexp.Regexp
+}
+
+// Schema describes CSV file without the need of Go structure.
+type Schema struct {
+	Header  bool     `json:"header" yaml:"header"`                       // True if first record is the header
+	Suggest bool     `json:"suggest,omitempty" yaml:"suggest,omitempty"` // Include suggested fixes in the report
+	Columns []Column `json:"columns" yaml:"columns"`
+}
+
+// Violation describes single value not conforming to the schema.
+type Violation struct {
+	Line       int    // Line in the CSV file
+	Column     string // Column name
+	Value      string // Offending value
+	Message    string // What is wrong with the value
+	Suggestion string // Suggested fix, empty when there is none
+}
+
+// ValidationReport lists schema violations found in CSV file.
+type ValidationReport struct {
+	Rows       int // Number of data records checked
+	Violations []Violation
+}
+
+// Valid returns true if no violations were found.
+func (vr *ValidationReport) Valid() bool {
+	return len(vr.Violations) == 0
+}
+
+// LoadSchema reads schema from JSON document.
+func LoadSchema(r io.Reader) (*Schema, error) {
+	s := &Schema{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, err
+	}
+	return s, s.Compile()
+}
+
+// Compile checks the schema definition and prepares it for validation.
+// It's called by ValidateFile and needs to be called only to check
+// schema constructed in code before it's used.
+func (s *Schema) Compile() (err error) {
+	for i := range s.Columns {
+		c := &s.Columns[i]
+		switch c.Type {
+		case "", TypeString, TypeInt, TypeFloat, TypeBool:
+		default:
+			return fmt.Errorf("column %s: unknown type %q", c.Name, c.Type)
+		}
+		if c.Pattern != "" && c.re == nil {
+			if c.re, err = regexp.Compile(c.Pattern); err != nil {
+				return fmt.Errorf("column %s: %v", c.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateFile validates all records read by r against the schema.
+// Returned error is not nil only when schema is invalid or file can't be
+// parsed as CSV, problems with values are reported in ValidationReport.
+func (s *Schema) ValidateFile(r *Reader) (*ValidationReport, error) {
+	if err := s.Compile(); err != nil {
+		return nil, err
+	}
+
+	report := &ValidationReport{}
+
+	// Column positions in the record.
+	pos := make([]int, len(s.Columns))
+	for i := range pos {
+		pos[i] = i
+	}
+
+	if s.Header {
+		header, err := r.read()
+		if err != nil {
+			if err == io.EOF {
+				return report, nil
+			}
+			return nil, err
+		}
+		idx := make(map[string]int, len(header))
+		for i, name := range header {
+			idx[name] = i
+		}
+		for i, c := range s.Columns {
+			p, ok := idx[c.Name]
+			if !ok {
+				p = -1
+				if c.Required {
+					report.add(1, c.Name, "", "column is missing")
+				}
+			}
+			pos[i] = p
+		}
+	}
+
+	for {
+		record, err := r.read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		report.Rows++
+		line, _ := r.fieldPos(0)
+
+		for i, c := range s.Columns {
+			if pos[i] < 0 {
+				continue
+			}
+			value := ""
+			if pos[i] < len(record) {
+				value = record[pos[i]]
+			}
+			if msg := c.check(value); msg != "" {
+				report.add(line, c.Name, value, msg)
+				if s.Suggest {
+					report.Violations[len(report.Violations)-1].Suggestion = c.suggest(value)
+				}
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// add adds violation to the report.
+func (vr *ValidationReport) add(line int, column, value, msg string) {
+	vr.Violations = append(vr.Violations, Violation{Line: line, Column: column, Value: value, Message: msg})
+}
+
+// check returns description of the problem with the value or empty string.
+func (c *Column) check(value string) string {
+	if value == "" {
+		if c.Required {
+			return "value is required"
+		}
+		return ""
+	}
+
+	var num float64
+	var err error
+	switch c.Type {
+	case TypeInt:
+		var i int64
+		i, err = strconv.ParseInt(value, 10, 64)
+		num = float64(i)
+	case TypeFloat:
+		num, err = strconv.ParseFloat(value, 64)
+	case TypeBool:
+		_, err = strconv.ParseBool(value)
+	}
+	if err != nil {
+		return "expected " + c.Type
+	}
+
+	if c.re != nil && !c.re.MatchString(value) {
+		return "does not match " + c.Pattern
+	}
+
+	if len(c.Enum) > 0 && indexOf(c.Enum, value) < 0 {
+		return "not one of allowed values"
+	}
+
+	if c.Type == TypeInt || c.Type == TypeFloat {
+		if c.Min != nil && num < *c.Min {
+			return "less than " + strconv.FormatFloat(*c.Min, 'f', -1, 64)
+		}
+		if c.Max != nil && num > *c.Max {
+			return "greater than " + strconv.FormatFloat(*c.Max, 'f', -1, 64)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,62 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+var testSchema = `{
+	"header": true,
+	"columns": [
+		{"name": "Name", "required": true, "pattern": "^[A-Z]"},
+		{"name": "Age", "type": "int", "min": 0, "max": 130},
+		{"name": "Email", "required": true}
+	]
+}`
+
+func Test_LoadSchema(t *testing.T) {
+	// Start test
+	s, err := LoadSchema(strings.NewReader(testSchema))
+	assert.NotError(t, err)
+	assert.Equal(t, true, s.Header)
+	assert.Equal(t, 3, len(s.Columns))
+	assert.Equal(t, float64(130), *s.Columns[1].Max)
+
+	_, err = LoadSchema(strings.NewReader(`{"columns": [{"name": "A", "type": "date"}]}`))
+	assert.NotNil(t, err)
+
+	_, err = LoadSchema(strings.NewReader(`{"columns": [{"name": "A", "pattern": "("}]}`))
+	assert.NotNil(t, err)
+}
+
+func Test_SchemaValidateFile(t *testing.T) {
+	// Prepare test
+	s, err := LoadSchema(strings.NewReader(testSchema))
+	assert.NotError(t, err)
+	sr := NewStringReadCloser("Age,Name\n23,Tony\nx,john\n200,\n")
+
+	// Start test
+	report, err := s.ValidateFile(NewCsvUtil(sr))
+	assert.NotError(t, err)
+	assert.Equal(t, false, report.Valid())
+	assert.Equal(t, 3, report.Rows)
+	assert.Equal(t, []Violation{
+		{Line: 1, Column: "Email", Message: "column is missing"},
+		{Line: 3, Column: "Name", Value: "john", Message: "does not match ^[A-Z]"},
+		{Line: 3, Column: "Age", Value: "x", Message: "expected int"},
+		{Line: 4, Column: "Name", Message: "value is required"},
+		{Line: 4, Column: "Age", Value: "200", Message: "greater than 130"},
+	}, report.Violations)
+}
+
+func Test_SchemaNoHeader(t *testing.T) {
+	// Prepare test
+	s := &Schema{Columns: []Column{{Name: "A", Type: TypeBool}, {Name: "B", Type: TypeFloat}}}
+	sr := NewStringReadCloser("true,1.5\nyes,2")
+
+	// Start test
+	report, err := s.ValidateFile(NewCsvUtil(sr))
+	assert.NotError(t, err)
+	assert.Equal(t, []Violation{{Line: 2, Column: "A", Value: "yes", Message: "expected bool"}}, report.Violations)
+}
@@ -0,0 +1,74 @@
+package csvutil
+
+import (
+	"io"
+)
+
+// ScrubControl removes control characters from the input before it's
+// parsed. They corrupt string values and break tools like PostgreSQL COPY
+// which reject NUL bytes. When chars is empty NUL, other ASCII control
+// characters except tab, new line and carriage return, and DEL are removed.
+// Only ASCII characters can be listed in chars. Input offsets reported by
+// RowMeta and Checkpoint don't count removed bytes, Scrubbed returns their
+// number. Must be called before the first record is read.
+//
+// Example:
+//
+//	c := csvutil.NewCsvUtil(f).ScrubControl("")
+//	// Decode records with c.SetData
+//	log.Printf("removed %d control characters", c.Scrubbed())
+func (r *Reader) ScrubControl(chars string) *Reader {
+	s := &scrubReader{}
+	if chars == "" {
+		for c := 0; c < 0x20; c++ {
+			s.set[c] = c != '\t' && c != '\n' && c != '\r'
+		}
+		s.set[0x7f] = true
+	}
+	for _, c := range chars {
+		if c >= 0x80 {
+			panic("csvutil: only ASCII characters can be scrubbed")
+		}
+		s.set[c] = true
+	}
+
+	r.scrub = s
+	r.wrap(func(src io.Reader) io.Reader {
+		s.r = src
+		return s
+	})
+	return r
+}
+
+// Scrubbed returns number of characters removed by ScrubControl so far.
+func (r *Reader) Scrubbed() int {
+	if r.scrub == nil {
+		return 0
+	}
+	return r.scrub.n
+}
+
+// scrubReader reads from r skipping bytes in set.
+type scrubReader struct {
+	r   io.Reader
+	set [0x80]bool
+	n   int // Number of removed bytes
+}
+
+func (s *scrubReader) Read(p []byte) (int, error) {
+	for {
+		n, err := s.r.Read(p)
+		j := 0
+		for _, c := range p[:n] {
+			if c < 0x80 && s.set[c] {
+				s.n++
+				continue
+			}
+			p[j] = c
+			j++
+		}
+		if j > 0 || n == 0 || err != nil {
+			return j, err
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_ScrubControl(t *testing.T) {
+	// Prepare test
+	c := FromString("To\x00ny,\"2\x073\"\r\n\x00\x00\nJo\x7fhn,34\tyears\n").FieldsPerRecord(-1).ScrubControl("")
+
+	// Start test
+	records, err := c.Head(5)
+	assert.NotError(t, err)
+	assert.Equal(t, [][]string{{"Tony", "23"}, {"John", "34\tyears"}}, records)
+	assert.Equal(t, 5, c.Scrubbed())
+
+	records, err = FromString("Tony;,23\n").ScrubControl(";").Head(1)
+	assert.NotError(t, err)
+	assert.Equal(t, [][]string{{"Tony", "23"}}, records)
+
+	assert.Equal(t, 0, FromString("").Scrubbed())
+	assert.Panic(t, func() { FromString("").ScrubControl("é") }, "csvutil: only ASCII characters can be scrubbed")
+}
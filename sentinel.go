@@ -0,0 +1,37 @@
+package csvutil
+
+import "errors"
+
+// ErrBatchBoundary is returned by SetData for records matched by the
+// sentinel matcher. The structure is not changed and reading can continue
+// with the next record.
+var ErrBatchBoundary = errors.New("batch boundary")
+
+// SentinelMatcher returns true for records which separate logical batches
+// of records instead of holding data.
+type SentinelMatcher func(record []string) bool
+
+// Sentinel sets matcher of records separating batches in the file.
+// SetData returns ErrBatchBoundary for them and Batches ends the current
+// batch there.
+//
+// Example:
+//
+//	// Batches are separated with "-","-","-" rows.
+//	c := NewCsvUtil(f).Sentinel(csvutil.SentinelRow("-"))
+func (r *Reader) Sentinel(m SentinelMatcher) *Reader {
+	r.sentinel = m
+	return r
+}
+
+// SentinelRow returns matcher of records with all fields equal to value.
+func SentinelRow(value string) SentinelMatcher {
+	return func(record []string) bool {
+		for _, field := range record {
+			if field != value {
+				return false
+			}
+		}
+		return len(record) > 0
+	}
+}
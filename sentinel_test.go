@@ -0,0 +1,46 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type feedRow struct {
+	Name string
+	Age  int
+}
+
+func Test_Sentinel(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,23\n\"-\",\"-\"\nJohn,34\n").Sentinel(SentinelRow("-"))
+
+	// Start test
+	got := &feedRow{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, ErrBatchBoundary, c.SetData(got))
+	assert.Equal(t, feedRow{"Tony", 23}, *got)
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, feedRow{"John", 34}, *got)
+}
+
+func Test_SentinelBatches(t *testing.T) {
+	// Prepare test
+	c := FromString("a,1\nb,2\n-,-\nc,3\nd,4\ne,5\n").Sentinel(SentinelRow("-"))
+
+	// Start test
+	var sizes []int
+	err := c.Batches(10, func() interface{} { return &feedRow{} }, func(batch []interface{}) error {
+		sizes = append(sizes, len(batch))
+		return nil
+	})
+	assert.NotError(t, err)
+	assert.Equal(t, []int{2, 3}, sizes)
+}
+
+func Test_SentinelRow(t *testing.T) {
+	// Start test
+	m := SentinelRow("-")
+	assert.Equal(t, true, m([]string{"-", "-"}))
+	assert.Equal(t, false, m([]string{"-", "x"}))
+	assert.Equal(t, false, m(nil))
+}
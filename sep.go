@@ -0,0 +1,29 @@
+package csvutil
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// SepDirective sets if the "sep=;" line Excel writes at the beginning of
+// the file is honoured (default: true). The line sets the delimiter and
+// is not returned as a record.
+func (r *Reader) SepDirective(b bool) *Reader {
+	r.ignoreSep = !b
+	return r
+}
+
+// sepDirective returns true if record is the delimiter directive and sets
+// the delimiter it declares.
+func (r *Reader) sepDirective(record []string) bool {
+	line := strings.Join(record, string(r.csvr.Comma))
+	if !strings.HasPrefix(line, "sep=") {
+		return false
+	}
+	comma, size := utf8.DecodeRuneInString(line[4:])
+	if size == 0 || 4+size != len(line) {
+		return false
+	}
+	r.csvr.Comma = comma
+	return true
+}
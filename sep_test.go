@@ -0,0 +1,43 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_SepDirective(t *testing.T) {
+	// Prepare test
+	c := FromString("sep=;\nTony;23;1.5;true\nJohn;34;2;false")
+
+	// Start test
+	p := &person{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person{Name: "Tony", Age: 23, Balance: 1.5, LowBalance: true}, *p)
+	line, _ := c.fieldPos(0)
+	assert.Equal(t, 2, line)
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "John", p.Name)
+
+	c = FromString("sep=,\nTony,23,1.5,true")
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "Tony", p.Name)
+
+	c = FromString("sep=\t\nTony\t23\t1.5\ttrue")
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, 23, p.Age)
+}
+
+func Test_SepDirectiveDisabled(t *testing.T) {
+	// Prepare test
+	c := FromString("sep=;\nTony").SepDirective(false)
+
+	// Start test
+	record, err := c.read()
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"sep=;"}, record)
+
+	c = FromString("sep=ab\n")
+	record, err = c.read()
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"sep=ab"}, record)
+}
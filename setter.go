@@ -0,0 +1,54 @@
+package csvutil
+
+import (
+	"reflect"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	stringType = reflect.TypeOf("")
+	errorType  = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// setterName returns name of the setter method of unexported field f of
+// structure type t or empty string if there is none.
+func setterName(t reflect.Type, f reflect.StructField) string {
+	r, n := utf8.DecodeRuneInString(f.Name)
+	name := "Set" + string(unicode.ToUpper(r)) + f.Name[n:]
+	m, ok := reflect.PtrTo(t).MethodByName(name)
+	if !ok {
+		return ""
+	}
+	mt := m.Type // Receiver is the first argument
+	if mt.NumIn() != 2 || mt.In(1) != stringType || mt.NumOut() != 1 || mt.Out(0) != errorType {
+		return ""
+	}
+	return name
+}
+
+// fieldSetter returns name of the setter method of unexported field f of
+// structure type t or empty string if the field is not tagged with setter
+// option. Panics if the tagged field has no setter method.
+func fieldSetter(t reflect.Type, f reflect.StructField) string {
+	if f.PkgPath == "" {
+		return ""
+	}
+	if _, opts := parseTag(f); !opts.has("setter") {
+		return ""
+	}
+	name := setterName(t, f)
+	if name == "" {
+		panic("Field " + f.Name + ": setter option requires Set method taking string and returning error")
+	}
+	return name
+}
+
+// callSetter calls setter method of the field f on structure v.
+func callSetter(v reflect.Value, f *sField, value string) error {
+	out := v.Addr().MethodByName(f.setter).Call([]reflect.Value{reflect.ValueOf(value)})
+	if err := out[0].Interface(); err != nil {
+		return err.(error)
+	}
+	return nil
+}
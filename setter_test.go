@@ -0,0 +1,69 @@
+package csvutil
+
+import (
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+type member struct {
+	Name  string
+	email string `csv:"Email,setter"`
+	age   int
+	note  string
+}
+
+func (m *member) SetEmail(s string) error {
+	if !strings.Contains(s, "@") {
+		return errors.New("invalid email")
+	}
+	m.email = s
+	return nil
+}
+
+// Field is not tagged with setter, note stays unbound.
+func (m *member) SetNote(s string) error {
+	m.note = s
+	return nil
+}
+
+// Wrong signature, age stays unbound.
+func (m *member) SetAge(age int) {
+	m.age = age
+}
+
+func Test_Setter(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,tony@example.com\nJohn,john\n").Header(CsvHeader{"Name": 0, "Email": 1})
+
+	// Start test
+	got := &member{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, member{Name: "Tony", email: "tony@example.com"}, *got)
+
+	err := c.SetData(got)
+	assert.Equal(t, "line 2, column Email: invalid email", err.Error())
+
+	assert.Equal(t, []UnboundField{{Name: "age", Reason: "unexported"}, {Name: "note", Reason: "unexported"}}, c.UnboundFields(&member{}))
+}
+
+func Test_SetterPositional(t *testing.T) {
+	// Prepare test
+	c := FromString("Tony,tony@example.com\n")
+
+	// Start test
+	got := &member{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, member{Name: "Tony", email: "tony@example.com"}, *got)
+}
+
+func Test_SetterMissing(t *testing.T) {
+	// Prepare test
+	type noSetter struct {
+		name string `csv:"Name,setter"`
+	}
+
+	// Start test
+	assert.Panic(t, func() { getFields(&noSetter{}) }, "Expected panic for setter option without method")
+}
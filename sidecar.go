@@ -0,0 +1,55 @@
+package csvutil
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// SidecarExt is the extension appended to CSV file name to get its sidecar file name.
+const SidecarExt = ".meta.json"
+
+// Sidecar is metadata stored next to the CSV file making it self describing.
+type Sidecar struct {
+	Dialect Dialect `json:"dialect"`
+	Schema  *Schema `json:"schema,omitempty"`
+	Rows    int     `json:"rows"` // Number of data records
+}
+
+// WriteSidecar writes the sidecar as JSON to w.
+func WriteSidecar(w io.Writer, s *Sidecar) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// ReadSidecar reads the sidecar written by WriteSidecar.
+func ReadSidecar(r io.Reader) (*Sidecar, error) {
+	s := &Sidecar{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, err
+	}
+	if s.Schema != nil {
+		if err := s.Schema.Compile(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Sidecar configures Reader from the sidecar. The dialect is applied and
+// when schema is present the columns are mapped by schema column names
+// and the header record is skipped.
+func (r *Reader) Sidecar(s *Sidecar) *Reader {
+	r.Dialect(s.Dialect)
+	if s.Schema != nil {
+		h := make(CsvHeader, len(s.Schema.Columns))
+		for i, c := range s.Schema.Columns {
+			h[c.Name] = i
+		}
+		r.Header(h)
+		if s.Schema.Header {
+			r.skip = 1
+		}
+	}
+	return r
+}
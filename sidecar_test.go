@@ -0,0 +1,42 @@
+package csvutil
+
+import (
+	"bytes"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_Sidecar(t *testing.T) {
+	// Prepare test
+	s := &Sidecar{
+		Dialect: Dialect{Delimiter: "|", True: []string{"Y"}, False: []string{"N"}},
+		Schema: &Schema{Header: true, Columns: []Column{
+			{Name: "LowBalance", Type: TypeBool},
+			{Name: "Name"},
+			{Name: "Age", Type: TypeInt},
+			{Name: "Balance", Type: TypeFloat},
+		}},
+		Rows: 1,
+	}
+
+	var buf bytes.Buffer
+	assert.NotError(t, WriteSidecar(&buf, s))
+
+	// Start test
+	got, err := ReadSidecar(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, 1, got.Rows)
+	assert.Equal(t, s.Dialect, got.Dialect)
+
+	sr := NewStringReadCloser("LowBalance|Name|Age|Balance\nY|Tony|23|1.5")
+	c := NewCsvUtil(sr).Sidecar(got)
+
+	p := &person{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person{Name: "Tony", Age: 23, Balance: 1.5, LowBalance: true}, *p)
+}
+
+func Test_ReadSidecarInvalidSchema(t *testing.T) {
+	_, err := ReadSidecar(bytes.NewBufferString(`{"schema": {"columns": [{"name": "A", "type": "x"}]}}`))
+	assert.NotNil(t, err)
+}
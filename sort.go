@@ -0,0 +1,97 @@
+package csvutil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// SortBy stably sorts slice of structures or pointers to structures by the
+// named fields before they are written with ToCsv. The fields are compared
+// in order, the next one is used only when previous ones are equal.
+//
+// Example:
+//
+//	err := csvutil.SortBy(people, "LastName", "FirstName")
+func SortBy(slice interface{}, fields ...string) error {
+	v := reflect.ValueOf(slice)
+	if v.Kind() != reflect.Slice {
+		return errors.New("expected slice")
+	}
+
+	elem := v.Type().Elem()
+	ptr := elem.Kind() == reflect.Ptr
+	if ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return errors.New("expected slice of structures")
+	}
+
+	idx := make([][]int, len(fields))
+	for i, name := range fields {
+		f, ok := elem.FieldByName(name)
+		if !ok {
+			return fmt.Errorf("no field %s in %s", name, elem)
+		}
+		if compare(reflect.Zero(f.Type), reflect.Zero(f.Type)) == -2 {
+			return fmt.Errorf("can't sort by field %s of type %s", name, f.Type)
+		}
+		idx[i] = f.Index
+	}
+
+	field := func(i int, f []int) reflect.Value {
+		e := v.Index(i)
+		if ptr {
+			e = e.Elem()
+		}
+		return e.FieldByIndex(f)
+	}
+
+	sort.SliceStable(slice, func(i, j int) bool {
+		for _, f := range idx {
+			if c := compare(field(i, f), field(j, f)); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	return nil
+}
+
+// compare returns -1, 0 or 1 if a is less, equal or greater than b.
+// Returns -2 if values of this type can't be compared.
+func compare(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.String:
+		return order(a.String() < b.String(), a.String() > b.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return order(a.Int() < b.Int(), a.Int() > b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return order(a.Uint() < b.Uint(), a.Uint() > b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return order(a.Float() < b.Float(), a.Float() > b.Float())
+	case reflect.Bool:
+		return order(!a.Bool() && b.Bool(), a.Bool() && !b.Bool())
+	case reflect.Struct:
+		if ta, ok := a.Interface().(time.Time); ok {
+			tb := b.Interface().(time.Time)
+			return order(ta.Before(tb), ta.After(tb))
+		}
+	}
+	return -2
+}
+
+// order returns -1 if less, 1 if greater and 0 otherwise.
+func order(less, greater bool) int {
+	if less {
+		return -1
+	}
+	if greater {
+		return 1
+	}
+	return 0
+}
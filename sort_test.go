@@ -0,0 +1,43 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type sortPerson struct {
+	LastName  string
+	FirstName string
+	Age       int
+	Tags      []string
+}
+
+func Test_SortBy(t *testing.T) {
+	// Prepare test
+	people := []sortPerson{
+		{LastName: "Smith", FirstName: "John", Age: 3},
+		{LastName: "Doe", FirstName: "Jane", Age: 2},
+		{LastName: "Smith", FirstName: "Anna", Age: 1},
+		{LastName: "Doe", FirstName: "Jane", Age: 1},
+	}
+
+	// Start test
+	assert.NotError(t, SortBy(people, "LastName", "FirstName"))
+	assert.Equal(t, []sortPerson{
+		{LastName: "Doe", FirstName: "Jane", Age: 2},
+		{LastName: "Doe", FirstName: "Jane", Age: 1},
+		{LastName: "Smith", FirstName: "Anna", Age: 1},
+		{LastName: "Smith", FirstName: "John", Age: 3},
+	}, people)
+
+	ptrs := []*sortPerson{&people[3], &people[0]}
+	assert.NotError(t, SortBy(ptrs, "Age"))
+	assert.Equal(t, 2, ptrs[0].Age)
+}
+
+func Test_SortByErrors(t *testing.T) {
+	assert.NotNil(t, SortBy(sortPerson{}, "Age"))
+	assert.NotNil(t, SortBy([]int{1}, "Age"))
+	assert.NotNil(t, SortBy([]sortPerson{}, "Missing"))
+	assert.NotNil(t, SortBy([]sortPerson{}, "Tags"))
+}
@@ -0,0 +1,155 @@
+package csvutil
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// ColumnStats summarizes values of a single CSV column.
+type ColumnStats struct {
+	Name    string  `json:"name"`
+	Nulls   int     `json:"nulls"`   // Number of empty or null values
+	Numeric int     `json:"numeric"` // Number of values parsed as finite numbers
+	Min     float64 `json:"min"`     // Minimum of numeric values
+	Max     float64 `json:"max"`     // Maximum of numeric values
+	Sum     float64 `json:"sum"`     // Sum of numeric values
+}
+
+// Stats summarizes CSV file. It can be stored as JSON and used as the
+// baseline files delivered later are compared with, see Stats.Compare.
+type Stats struct {
+	Rows    int           `json:"rows"` // Number of data records
+	Columns []ColumnStats `json:"columns"`
+}
+
+// Profile reads all remaining records and returns their statistics. When
+// header is true the first record names the columns, otherwise they are
+// named by position: #1, #2 and so on, or col_1, col_2 with NumberedHeader.
+func (r *Reader) Profile(header bool) (*Stats, error) {
+	if header && r.columns == nil {
+		if err := r.readHeader(); err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+
+	s := &Stats{}
+	for _, name := range r.columns {
+		s.Columns = append(s.Columns, ColumnStats{Name: name})
+	}
+
+	for {
+		record, err := r.read()
+		if err == io.EOF {
+			return s, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		s.add(record, r.null, r.columnName)
+	}
+}
+
+// add adds the record to the statistics. New columns are named by name
+// called with their index.
+func (s *Stats) add(record []string, null string, name func(i int) string) {
+	s.Rows++
+	for i, value := range record {
+		for i >= len(s.Columns) {
+			s.Columns = append(s.Columns, ColumnStats{Name: name(len(s.Columns))})
+		}
+		s.Columns[i].add(value, null)
+	}
+}
+
+// add adds the value to column statistics.
+func (cs *ColumnStats) add(value, null string) {
+	if value == "" || (null != "" && value == null) {
+		cs.Nulls++
+		return
+	}
+	// NaN and infinities are not numbers for the statistics, they can't
+	// be compared nor stored as JSON.
+	num, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(num) || math.IsInf(num, 0) {
+		return
+	}
+	if cs.Numeric == 0 || num < cs.Min {
+		cs.Min = num
+	}
+	if cs.Numeric == 0 || num > cs.Max {
+		cs.Max = num
+	}
+	cs.Sum += num
+	cs.Numeric++
+}
+
+// AnomalyThresholds configures Stats.Compare. Zero disables the check.
+type AnomalyThresholds struct {
+	RowDrop    float64 // Maximum relative drop of the row count, 0.2 is 20%
+	NullRise   float64 // Maximum rise of the share of null values in a column, 0.1 is 10 percentage points
+	RangeShift float64 // Maximum shift of numeric values beyond the baseline range relative to its width
+}
+
+// Anomaly describes suspicious difference between the file and its baseline.
+type Anomaly struct {
+	Column  string // Column name, empty for the whole file
+	Message string // What is suspicious
+}
+
+// Compare returns anomalies of the current file statistics compared to
+// the baseline s. Columns missing in the current file are always reported.
+//
+// Example:
+//
+//	anomalies := baseline.Compare(current, csvutil.AnomalyThresholds{RowDrop: 0.2, NullRise: 0.1, RangeShift: 0.5})
+func (s *Stats) Compare(current *Stats, t AnomalyThresholds) []Anomaly {
+	var anomalies []Anomaly
+
+	if t.RowDrop > 0 && s.Rows > 0 {
+		if drop := float64(s.Rows-current.Rows) / float64(s.Rows); drop > t.RowDrop {
+			anomalies = append(anomalies, Anomaly{Message: fmt.Sprintf("row count dropped from %d to %d", s.Rows, current.Rows)})
+		}
+	}
+
+	byName := make(map[string]*ColumnStats, len(current.Columns))
+	for i := range current.Columns {
+		byName[current.Columns[i].Name] = &current.Columns[i]
+	}
+
+	for _, base := range s.Columns {
+		cs, ok := byName[base.Name]
+		if !ok {
+			anomalies = append(anomalies, Anomaly{Column: base.Name, Message: "column is missing"})
+			continue
+		}
+
+		if t.NullRise > 0 && s.Rows > 0 && current.Rows > 0 {
+			was := float64(base.Nulls) / float64(s.Rows)
+			is := float64(cs.Nulls) / float64(current.Rows)
+			if is-was > t.NullRise {
+				anomalies = append(anomalies, Anomaly{Column: base.Name, Message: fmt.Sprintf("null values rose from %s%% to %s%%", percent(was), percent(is))})
+			}
+		}
+
+		if t.RangeShift > 0 && base.Numeric > 0 && cs.Numeric > 0 {
+			margin := (base.Max - base.Min) * t.RangeShift
+			if cs.Min < base.Min-margin || cs.Max > base.Max+margin {
+				anomalies = append(anomalies, Anomaly{Column: base.Name, Message: fmt.Sprintf("values range %s..%s is outside of %s..%s", formatNum(cs.Min), formatNum(cs.Max), formatNum(base.Min), formatNum(base.Max))})
+			}
+		}
+	}
+
+	return anomalies
+}
+
+// percent formats share as percentage.
+func percent(share float64) string {
+	return strconv.FormatFloat(share*100, 'f', 1, 64)
+}
+
+// formatNum formats number in the shortest form.
+func formatNum(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,61 @@
+package csvutil
+
+import (
+	"encoding/json"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_Profile(t *testing.T) {
+	// Prepare test
+	c := NewCsvUtil(NewStringReadCloser("Name,Age\nTony,23\nJohn,\nAnn,41\n")).Null("NULL")
+
+	// Start test
+	s, err := c.Profile(true)
+	assert.NotError(t, err)
+	assert.Equal(t, &Stats{Rows: 3, Columns: []ColumnStats{
+		{Name: "Name"},
+		{Name: "Age", Nulls: 1, Numeric: 2, Min: 23, Max: 41, Sum: 64},
+	}}, s)
+
+	s, err = NewCsvUtil(NewStringReadCloser("a,1\nb,NULL")).Null("NULL").Profile(false)
+	assert.NotError(t, err)
+	assert.Equal(t, "#2", s.Columns[1].Name)
+	assert.Equal(t, 1, s.Columns[1].Nulls)
+}
+
+func Test_ProfileNotFinite(t *testing.T) {
+	// Prepare test
+	c := NewCsvUtil(NewStringReadCloser("NaN\n5\n+Inf\n-inf\n2\n"))
+
+	// Start test
+	s, err := c.Profile(false)
+	assert.NotError(t, err)
+	assert.Equal(t, ColumnStats{Name: "#1", Numeric: 2, Min: 2, Max: 5, Sum: 7}, s.Columns[0])
+	_, err = json.Marshal(s)
+	assert.NotError(t, err)
+}
+
+func Test_StatsCompare(t *testing.T) {
+	// Prepare test
+	baseline := &Stats{Rows: 100, Columns: []ColumnStats{
+		{Name: "Name", Nulls: 0},
+		{Name: "Age", Nulls: 5, Numeric: 95, Min: 18, Max: 68},
+		{Name: "Email", Nulls: 10},
+	}}
+	current := &Stats{Rows: 70, Columns: []ColumnStats{
+		{Name: "Name", Nulls: 1},
+		{Name: "Age", Nulls: 30, Numeric: 40, Min: 0, Max: 70},
+	}}
+	th := AnomalyThresholds{RowDrop: 0.2, NullRise: 0.1, RangeShift: 0.2}
+
+	// Start test
+	assert.Equal(t, []Anomaly{
+		{Message: "row count dropped from 100 to 70"},
+		{Column: "Age", Message: "null values rose from 5.0% to 42.9%"},
+		{Column: "Age", Message: "values range 0..70 is outside of 18..68"},
+		{Column: "Email", Message: "column is missing"},
+	}, baseline.Compare(current, th))
+
+	assert.Equal(t, []Anomaly{{Column: "Email", Message: "column is missing"}}, baseline.Compare(current, AnomalyThresholds{}))
+}
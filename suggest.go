@@ -0,0 +1,137 @@
+package csvutil
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Currency codes around amounts, e.g. "USD 12.50" or "12,50 EUR".
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}\s*|\s*[A-Z]{3}$`)
+
+// suggest returns value fixing the problem with the column value or empty
+// string when no fix is found. Suggestions are:
+//
+//   - the nearest allowed value by edit distance,
+//   - the number with currency symbols, codes and thousands separators removed,
+//   - the date written in another format matching the column pattern.
+//
+// Only suggestions passing the column check are returned.
+func (c *Column) suggest(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	var candidates []string
+	if len(c.Enum) > 0 {
+		candidates = append(candidates, nearest(value, c.Enum))
+	}
+	switch c.Type {
+	case TypeInt, TypeFloat:
+		candidates = append(candidates, cleanNumber(value, c.Type))
+	case "", TypeString:
+		if c.re != nil {
+			candidates = append(candidates, redate(value)...)
+		}
+	}
+
+	for _, s := range candidates {
+		if s != "" && s != value && c.check(s) == "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// nearest returns allowed value closest to value by edit distance or empty
+// string when none is close enough.
+func nearest(value string, allowed []string) string {
+	best, bestDist := "", -1
+	for _, a := range allowed {
+		if strings.EqualFold(a, value) {
+			return a
+		}
+		d := editDistance(strings.ToLower(value), strings.ToLower(a))
+		if limit := utf8.RuneCountInString(a) / 3; d > limit && d > 1 {
+			continue
+		}
+		if bestDist < 0 || d < bestDist {
+			best, bestDist = a, d
+		}
+	}
+	return best
+}
+
+// editDistance returns Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// min3 returns the smallest of three integers.
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// cleanNumber returns value with currency symbols, codes, spaces and
+// thousands separators removed. Integers written with zero fraction lose it.
+func cleanNumber(value, typ string) string {
+	value = currencyCode.ReplaceAllString(strings.TrimSpace(value), "")
+	value = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Sc, r) || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, value)
+	if thousandsNumber.MatchString(value) {
+		value = strings.Replace(value, ",", "", -1)
+	}
+	if typ == TypeInt {
+		if i := strings.IndexByte(value, '.'); i >= 0 && strings.Trim(value[i+1:], "0") == "" {
+			value = value[:i]
+		}
+	}
+	return value
+}
+
+// redate returns the date value written in all other known formats.
+func redate(value string) []string {
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		var dates []string
+		for _, other := range dateLayouts {
+			if other != layout {
+				dates = append(dates, t.Format(other))
+			}
+		}
+		return dates
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+func Test_ColumnSuggest(t *testing.T) {
+	// Prepare test
+	s := &Schema{Columns: []Column{
+		{Name: "Status", Enum: []string{"active", "suspended", "closed"}},
+		{Name: "Amount", Type: TypeFloat},
+		{Name: "Qty", Type: TypeInt},
+		{Name: "Date", Pattern: `^[0-9]{4}-[0-9]{2}-[0-9]{2}$`},
+	}}
+	assert.NotError(t, s.Compile())
+	c := s.Columns
+
+	// Start test
+	assert.Equal(t, "active", c[0].suggest("Active"))
+	assert.Equal(t, "suspended", c[0].suggest("suspnded"))
+	assert.Equal(t, "", c[0].suggest("pending"))
+	assert.Equal(t, "1234.50", c[1].suggest("$1,234.50"))
+	assert.Equal(t, "12.5", c[1].suggest("EUR 12.5"))
+	assert.Equal(t, "12", c[2].suggest("12.00"))
+	assert.Equal(t, "", c[2].suggest("12.50"))
+	assert.Equal(t, "2023-01-31", c[3].suggest("01/31/2023"))
+	assert.Equal(t, "2023-01-31", c[3].suggest("31.01.2023"))
+	assert.Equal(t, "", c[3].suggest("yesterday"))
+}
+
+func Test_SchemaSuggest(t *testing.T) {
+	// Prepare test
+	s := &Schema{Suggest: true, Columns: []Column{{Name: "Status", Enum: []string{"active", "closed"}}, {Name: "Amount", Type: TypeFloat}}}
+	sr := NewStringReadCloser("active,1.5\nclosd,£2")
+
+	// Start test
+	report, err := s.ValidateFile(NewCsvUtil(sr))
+	assert.NotError(t, err)
+	assert.Equal(t, []Violation{
+		{Line: 2, Column: "Status", Value: "closd", Message: "not one of allowed values", Suggestion: "closed"},
+		{Line: 2, Column: "Amount", Value: "£2", Message: "expected float", Suggestion: "2"},
+	}, report.Violations)
+
+	s.Suggest = false
+	report, err = s.ValidateFile(NewCsvUtil(strings.NewReader("closd,1")))
+	assert.NotError(t, err)
+	assert.Equal(t, "", report.Violations[0].Suggestion)
+}
@@ -0,0 +1,45 @@
+package csvutil
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Numbers with commas separating thousands, e.g. 1,234,567.89.
+var thousandsNumber = regexp.MustCompile(`^[+-]?[0-9]{1,3}(,[0-9]{3})+(\.[0-9]+)?$`)
+
+// QuotedThousands makes SetData accept numbers with commas separating
+// thousands ("1,234.5") in numeric fields when the value is quoted, as
+// spreadsheets export them. Unquoted values are parsed strictly.
+// Must be called before the first record is read.
+func (r *Reader) QuotedThousands(b bool) *Reader {
+	r.thousands = b
+	if b {
+		r.tapInput()
+	}
+	return r
+}
+
+// stripThousands removes thousands separators from quoted value of the
+// numeric field f.
+func (r *Reader) stripThousands(elem reflect.Value, f *sField, value string) string {
+	if !r.thousands || !thousandsNumber.MatchString(value) {
+		return value
+	}
+	t := elem.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	default:
+		return value
+	}
+	if !r.quoted(r.header[f.col]) {
+		return value
+	}
+	return strings.Replace(value, ",", "", -1)
+}
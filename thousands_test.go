@@ -0,0 +1,43 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type amounts struct {
+	Label  string
+	Count  int
+	Total  float64
+	Weight *uint
+}
+
+func Test_QuotedThousands(t *testing.T) {
+	// Prepare test
+	c := FromString("\"1,234\",\"1,234\",\"-12,345.5\",\"1,000\"\n1,2,3.5,4").QuotedThousands(true)
+
+	// Start test
+	v := &amounts{}
+	assert.NotError(t, c.SetData(v))
+	assert.Equal(t, "1,234", v.Label)
+	assert.Equal(t, 1234, v.Count)
+	assert.Equal(t, -12345.5, v.Total)
+	assert.Equal(t, uint(1000), *v.Weight)
+
+	assert.NotError(t, c.SetData(v))
+	assert.Equal(t, 2, v.Count)
+}
+
+func Test_QuotedThousandsStrict(t *testing.T) {
+	// Prepare test
+	c := FromString("a;1,234;0;0").Comma(';').QuotedThousands(true)
+
+	// Start test
+	assert.NotNil(t, c.SetData(&amounts{}))
+
+	c = FromString("a,\"1,234\",0,0")
+	assert.NotNil(t, c.SetData(&amounts{}))
+
+	c = FromString("a,\"12,34\",0,0").QuotedThousands(true)
+	assert.NotNil(t, c.SetData(&amounts{}))
+}
@@ -0,0 +1,59 @@
+package csvutil
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ThresholdError is returned by Pipeline.Run when too many rows fail.
+type ThresholdError struct {
+	Column string  // Column which failed too often, empty for the error rate
+	Errors int     // Number of failures
+	Rows   int     // Number of rows read
+	Limit  float64 // The exceeded limit
+}
+
+func (e *ThresholdError) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf("column %s failed %d times, limit %d", e.Column, e.Errors, int(e.Limit))
+	}
+	return fmt.Sprintf("%d of %d rows failed, limit %s%%", e.Errors, e.Rows, strconv.FormatFloat(e.Limit*100, 'f', -1, 64))
+}
+
+// MaxErrorRate aborts Run when more than rate (0.01 is 1%) of rows fail.
+// The rate is checked after minRows rows have been read, so few bad rows
+// at the beginning don't stop the run, and once more at the end.
+func (p *Pipeline) MaxErrorRate(rate float64, minRows int) *Pipeline {
+	p.maxRate = rate
+	p.minRows = minRows
+	p.rateSet = true
+	return p
+}
+
+// MaxColumnErrors aborts Run when values of the column fail more than n
+// times. Only failures reporting the column, *FieldError, are counted.
+func (p *Pipeline) MaxColumnErrors(column string, n int) *Pipeline {
+	if p.columnLimits == nil {
+		p.columnLimits = make(map[string]int)
+	}
+	p.columnLimits[column] = n
+	return p
+}
+
+// checkThresholds returns ThresholdError when failures in the report
+// exceed the limits. The error rate is checked regardless of minRows
+// when done is true.
+func (p *Pipeline) checkThresholds(report *PipelineReport, done bool) error {
+	for col, n := range p.columnLimits {
+		if report.ColumnErrors[col] > n {
+			return &ThresholdError{Column: col, Errors: report.ColumnErrors[col], Rows: report.Rows, Limit: float64(n)}
+		}
+	}
+	if !p.rateSet || report.Rows == 0 || (!done && report.Rows < p.minRows) {
+		return nil
+	}
+	if float64(len(report.Errors)) > p.maxRate*float64(report.Rows) {
+		return &ThresholdError{Errors: len(report.Errors), Rows: report.Rows, Limit: p.maxRate}
+	}
+	return nil
+}
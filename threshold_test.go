@@ -0,0 +1,47 @@
+package csvutil
+
+import (
+	"context"
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+)
+
+type thresholdPerson struct {
+	Name  string
+	Email string `validate:"minlen=1"`
+}
+
+func Test_PipelineMaxErrorRate(t *testing.T) {
+	// Prepare test
+	data := "Tony,t@x\nJohn,\nAnn,a@x\nJo,j@x\n"
+	newRow := func() interface{} { return &thresholdPerson{} }
+
+	// Start test
+	report, err := NewPipeline(ReaderSource(strings.NewReader(data)), newRow).MaxErrorRate(0.25, 10).Run(context.Background())
+	assert.NotError(t, err)
+	assert.Equal(t, 1, len(report.Errors))
+
+	report, err = NewPipeline(ReaderSource(strings.NewReader(data)), newRow).MaxErrorRate(0.2, 10).Run(context.Background())
+	assert.Equal(t, &ThresholdError{Errors: 1, Rows: 4, Limit: 0.2}, err)
+	assert.Equal(t, "1 of 4 rows failed, limit 20%", err.Error())
+
+	report, err = NewPipeline(ReaderSource(strings.NewReader(data)), newRow).MaxErrorRate(0.2, 2).Run(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, report.Rows)
+}
+
+func Test_PipelineMaxColumnErrors(t *testing.T) {
+	// Prepare test
+	data := "Tony,\nJohn,\nAnn,a@x\nJo,\n"
+	newRow := func() interface{} { return &thresholdPerson{} }
+
+	// Start test
+	report, err := NewPipeline(ReaderSource(strings.NewReader(data)), newRow).MaxColumnErrors("Email", 3).Run(context.Background())
+	assert.NotError(t, err)
+	assert.Equal(t, map[string]int{"Email": 3}, report.ColumnErrors)
+
+	report, err = NewPipeline(ReaderSource(strings.NewReader(data)), newRow).MaxColumnErrors("Email", 1).Run(context.Background())
+	assert.Equal(t, "column Email failed 2 times, limit 1", err.Error())
+	assert.Equal(t, 2, report.Rows)
+}
@@ -0,0 +1,60 @@
+package csvutil
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Throttle limits the rate at which the input stream is read to bytesPerSec.
+// Reading stops with ctx.Err() as soon as ctx is cancelled.
+// Must be called before the first record is read. Panics if bytesPerSec is
+// not positive.
+func (r *Reader) Throttle(ctx context.Context, bytesPerSec int) *Reader {
+	if bytesPerSec <= 0 {
+		panic("csvutil: throttle rate must be positive")
+	}
+	r.wrap(func(src io.Reader) io.Reader {
+		return &throttledReader{r: src, ctx: ctx, rate: bytesPerSec}
+	})
+	return r
+}
+
+// throttledReader reads from r no faster than rate bytes per second.
+type throttledReader struct {
+	r     io.Reader
+	ctx   context.Context
+	rate  int
+	start time.Time // Time of the first read
+	total int64     // Bytes read so far
+}
+
+func (t *throttledReader) Read(p []byte) (int, error) {
+	if err := t.ctx.Err(); err != nil {
+		return 0, err
+	}
+	if t.start.IsZero() {
+		t.start = time.Now()
+	}
+
+	// Never read more than one second worth of data at once.
+	if len(p) > t.rate {
+		p = p[:t.rate]
+	}
+
+	n, err := t.r.Read(p)
+	t.total += int64(n)
+
+	wait := time.Duration(t.total)*time.Second/time.Duration(t.rate) - time.Since(t.start)
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-t.ctx.Done():
+			return n, t.ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return n, err
+}
@@ -0,0 +1,47 @@
+package csvutil
+
+import (
+	"context"
+	"github.com/rzajac/goassert/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_Throttle(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser(strings.Repeat("a,b\n", 50))
+	c := NewCsvUtil(sr).Throttle(context.Background(), 1000)
+
+	// Start test
+	start := time.Now()
+	n := 0
+	for {
+		if _, err := c.read(); err != nil {
+			break
+		}
+		n++
+	}
+	assert.Equal(t, 50, n)
+	assert.Equal(t, true, time.Since(start) >= 150*time.Millisecond)
+}
+
+func Test_ThrottleCancel(t *testing.T) {
+	// Prepare test
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sr := NewStringReadCloser("a,b\n")
+	c := NewCsvUtil(sr).Throttle(ctx, 1000)
+
+	// Start test
+	_, err := c.read()
+	assert.Equal(t, context.Canceled, err)
+}
+
+func Test_ThrottleRate(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("a,b\n")
+
+	// Start test
+	assert.Panic(t, func() { NewCsvUtil(sr).Throttle(context.Background(), 0) }, "Expected panic for zero rate")
+}
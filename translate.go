@@ -0,0 +1,35 @@
+package csvutil
+
+import "io"
+
+// Translate adds dictionary translating CSV header names read with
+// HeaderFromFirstRow to column names of the structure, e.g. "Nombre" to
+// "Name". Can be called many times to merge dictionaries of many languages.
+func (r *Reader) Translate(dict map[string]string) *Reader {
+	if r.translations == nil {
+		r.translations = make(map[string]string, len(dict))
+	}
+	for from, to := range dict {
+		r.translations[from] = to
+	}
+	return r.HeaderFromFirstRow()
+}
+
+// LoadTranslations reads translation dictionary from all remaining records
+// read by r. Each record holds localized header name followed by the column
+// name it translates to.
+func LoadTranslations(r *Reader) (map[string]string, error) {
+	dict := make(map[string]string)
+	for {
+		record, err := r.read()
+		if err == io.EOF {
+			return dict, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) >= 2 {
+			dict[record[0]] = record[1]
+		}
+	}
+}
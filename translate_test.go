@@ -0,0 +1,26 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+func Test_Translate(t *testing.T) {
+	// Prepare test
+	es, err := LoadTranslations(NewCsvUtil(NewStringReadCloser("Nombre,Name\nSaldo,Balance")))
+	assert.NotError(t, err)
+	assert.Equal(t, map[string]string{"Nombre": "Name", "Saldo": "Balance"}, es)
+
+	fr := map[string]string{"Nom": "Name", "Solde": "Balance"}
+
+	// Start test
+	c := NewCsvUtil(NewStringReadCloser("Saldo,Nombre\n1.5,Tony")).Translate(es).Translate(fr)
+	p := &person2{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person2{Name: "Tony", Balance: 1.5}, *p)
+	assert.Equal(t, []string{"Saldo", "Nombre"}, c.Columns())
+
+	c = NewCsvUtil(NewStringReadCloser("Nom,Solde\nAnna,2")).Translate(es).Translate(fr)
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, person2{Name: "Anna", Balance: 2}, *p)
+}
@@ -0,0 +1,72 @@
+package csvutil
+
+import (
+	"context"
+	"fmt"
+)
+
+// TwoPhase makes Run read the source twice. The first pass only decodes
+// and validates the rows and checks the thresholds, see MaxErrorRate and
+// MaxColumnErrors. When they are exceeded Run returns ThresholdError and
+// nothing reaches the sink. Otherwise the second pass filters, transforms
+// and sinks the rows, skipping those which failed in the first pass
+// without decoding them again. The source must be possible to open twice,
+// like FileSource or URLSource. ReaderSource can't be read again and Run
+// fails when the second pass reads different number of rows than the first.
+//
+// Example:
+//
+//	report, err := csvutil.NewPipeline(csvutil.FileSource("people.csv"), newPerson).
+//		MaxErrorRate(0.01, 0).
+//		TwoPhase().
+//		Sink(csvutil.SQLSink(db, query)).
+//		Run(ctx)
+func (p *Pipeline) TwoPhase() *Pipeline {
+	p.twoPhase = true
+	return p
+}
+
+// runTwoPhase validates all rows before passing them to the sink. The
+// returned report has failures of both passes.
+func (p *Pipeline) runTwoPhase(ctx context.Context) (*PipelineReport, error) {
+	failed := make(map[int64]bool)
+	checked, err := p.run(ctx, true, failed)
+	if err != nil {
+		return checked, err
+	}
+
+	report, err := p.run(ctx, false, failed)
+	if report == nil {
+		return checked, err
+	}
+	if err == nil && report.Rows != checked.Rows {
+		err = fmt.Errorf("source changed between passes: checked %d rows, read %d", checked.Rows, report.Rows)
+	}
+	report.Errors = append(checked.Errors, report.Errors...)
+	for col, n := range checked.ColumnErrors {
+		if report.ColumnErrors == nil {
+			report.ColumnErrors = make(map[string]int)
+		}
+		report.ColumnErrors[col] += n
+	}
+	return report, err
+}
+
+// skipFailed reads the next record and returns true if it failed in the
+// first pass. Otherwise the record is left for SetData.
+func skipFailed(r *Reader, failed map[int64]bool) (bool, error) {
+	if r.headerRow && r.columns == nil {
+		if err := r.readHeader(); err != nil {
+			return false, err
+		}
+	}
+	_, err := r.read()
+	if failed[r.offset] {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	r.peeked = true
+	return false, nil
+}
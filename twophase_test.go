@@ -0,0 +1,67 @@
+package csvutil
+
+import (
+	"context"
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func Test_PipelineTwoPhase(t *testing.T) {
+	// Prepare test
+	var opened int
+	src := func(ctx context.Context) (io.ReadCloser, error) {
+		opened++
+		return ioutil.NopCloser(strings.NewReader("Tony,t@x\nJohn,\nbro\"ken,x\nAnn,a@x\n")), nil
+	}
+	var sunk []string
+	p := NewPipeline(src, func() interface{} { return &thresholdPerson{} }).
+		Configure(func(r *Reader) { r.FieldsPerRecord(-1) }).
+		Validate(func(row int, v interface{}) error {
+			if v.(*thresholdPerson).Name == "Ann" {
+				return errors.New("banned")
+			}
+			return nil
+		}).
+		Sink(func(ctx context.Context, v interface{}) error {
+			sunk = append(sunk, v.(*thresholdPerson).Name)
+			return nil
+		}).
+		TwoPhase()
+
+	// Start test
+	report, err := p.MaxErrorRate(0.75, 0).Run(context.Background())
+	assert.NotError(t, err)
+	assert.Equal(t, 2, opened)
+	assert.Equal(t, []string{"Tony"}, sunk)
+	assert.Equal(t, 4, report.Rows)
+	assert.Equal(t, 1, report.Written)
+	assert.Equal(t, 3, len(report.Errors))
+	assert.Equal(t, map[string]int{"Email": 1}, report.ColumnErrors)
+
+	opened, sunk = 0, nil
+	_, err = p.MaxErrorRate(0.5, 0).Run(context.Background())
+	assert.Equal(t, &ThresholdError{Errors: 2, Rows: 3, Limit: 0.5}, err)
+	assert.Equal(t, 1, opened)
+	assert.Equal(t, 0, len(sunk))
+}
+
+func Test_PipelineTwoPhaseDrained(t *testing.T) {
+	// Prepare test
+	src := ReaderSource(strings.NewReader("Tony,t@x\nJohn,j@x\n"))
+	var sunk int
+	p := NewPipeline(src, func() interface{} { return &thresholdPerson{} }).
+		Sink(func(ctx context.Context, v interface{}) error {
+			sunk++
+			return nil
+		}).
+		TwoPhase()
+
+	// Start test
+	_, err := p.Run(context.Background())
+	assert.Equal(t, "source changed between passes: checked 2 rows, read 0", err.Error())
+	assert.Equal(t, 0, sunk)
+}
@@ -0,0 +1,131 @@
+package csvutil
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// errUnknownType is returned when no row type is registered for the value
+// of the discriminator column.
+var errUnknownType = errors.New("unknown row type")
+
+// RowTypes maps values of the discriminator column to functions returning
+// pointers to new structures the rows are decoded into.
+type RowTypes map[string]func() interface{}
+
+// TypeSwitch sets the column deciding which structure the row is decoded
+// into, for files mixing several kinds of records. Rows are read with
+// ReadSwitch or Visit. The column is looked up in the header, or when there
+// is none, in the structures which must all have it at the same position.
+// Files with records of different lengths need FieldsPerRecord(-1).
+//
+// Example:
+//
+//	r := csvutil.NewCsvUtil(f).FieldsPerRecord(-1).TypeSwitch("record_type", csvutil.RowTypes{
+//		"1": func() interface{} { return &fileHeader{} },
+//		"6": func() interface{} { return &entry{} },
+//		"9": func() interface{} { return &fileControl{} },
+//	})
+func (r *Reader) TypeSwitch(column string, types RowTypes) *Reader {
+	r.switchCol = column
+	r.switchTypes = types
+	r.switchIdx = -1
+	return r
+}
+
+// ReadSwitch reads the next record and returns pointer to the structure
+// registered for the value of the TypeSwitch column, populated as SetData
+// does. Rows with unknown type are returned as FieldError, reading can
+// continue with the next row. Returns io.EOF when no more records exist.
+func (r *Reader) ReadSwitch() (interface{}, error) {
+	if r.switchTypes == nil {
+		panic("csvutil: ReadSwitch called without TypeSwitch")
+	}
+	if r.headerRow && r.columns == nil {
+		if err := r.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	record, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	idx, err := r.switchIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	var value string
+	if idx < len(record) {
+		value = record[idx]
+	}
+	newRow, ok := r.switchTypes[value]
+	if !ok {
+		line, _ := r.fieldPos(0)
+		return nil, &FieldError{Line: line, Column: r.switchCol, Value: value, Err: errUnknownType}
+	}
+
+	v := newRow()
+	r.peeked = true
+	if err = r.SetData(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Visit calls fn with every remaining row read by ReadSwitch. It stops at
+// the first error returned by ReadSwitch or fn.
+func (r *Reader) Visit(fn func(v interface{}) error) error {
+	for {
+		v, err := r.ReadSwitch()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = fn(v); err != nil {
+			return err
+		}
+	}
+}
+
+// switchIndex returns index of the TypeSwitch column.
+func (r *Reader) switchIndex() (int, error) {
+	if r.switchIdx >= 0 {
+		return r.switchIdx, nil
+	}
+
+	if r.customHeader {
+		idx, ok := r.header[r.switchCol]
+		if !ok {
+			return 0, fmt.Errorf("type switch: column %s not in CSV header", r.switchCol)
+		}
+		r.switchIdx = idx
+		return idx, nil
+	}
+
+	names := make([]string, 0, len(r.switchTypes))
+	for name := range r.switchTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	idx := -1
+	for _, name := range names {
+		fields, structName := getFields(r.switchTypes[name]())
+		i, ok := getHeaders(fields)[r.switchCol]
+		if !ok {
+			return 0, fmt.Errorf("type switch: column %s not in %s", r.switchCol, structName)
+		}
+		if idx >= 0 && i != idx {
+			return 0, fmt.Errorf("type switch: column %s is not at the same position in all types", r.switchCol)
+		}
+		idx = i
+	}
+	r.switchIdx = idx
+	return idx, nil
+}
@@ -0,0 +1,73 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type fileHeader struct {
+	RecordType string `csv:"record_type"`
+	Origin     string
+}
+
+type entry struct {
+	RecordType string `csv:"record_type"`
+	Account    string
+	Amount     int
+}
+
+type fileControl struct {
+	RecordType string `csv:"record_type"`
+	Count      int
+}
+
+var nachaTypes = RowTypes{
+	"1": func() interface{} { return &fileHeader{} },
+	"6": func() interface{} { return &entry{} },
+	"9": func() interface{} { return &fileControl{} },
+}
+
+func Test_TypeSwitch(t *testing.T) {
+	// Prepare test
+	c := FromString("1,ACME\n6,001,100\n6,002,250\n9,2\n").FieldsPerRecord(-1).TypeSwitch("record_type", nachaTypes)
+
+	// Start test
+	var rows []interface{}
+	err := c.Visit(func(v interface{}) error {
+		rows = append(rows, v)
+		return nil
+	})
+	assert.NotError(t, err)
+	assert.Equal(t, []interface{}{
+		&fileHeader{"1", "ACME"},
+		&entry{"6", "001", 100},
+		&entry{"6", "002", 250},
+		&fileControl{"9", 2},
+	}, rows)
+}
+
+func Test_TypeSwitchHeader(t *testing.T) {
+	// Prepare test
+	c := FromString("Account,record_type,Amount\n001,6,100\n002,7,200\n").
+		HeaderFromFirstRow().
+		TypeSwitch("record_type", nachaTypes)
+
+	// Start test
+	v, err := c.ReadSwitch()
+	assert.NotError(t, err)
+	assert.Equal(t, &entry{"6", "001", 100}, v)
+
+	_, err = c.ReadSwitch()
+	assert.Equal(t, &FieldError{Line: 3, Column: "record_type", Value: "7", Err: errUnknownType}, err)
+}
+
+func Test_TypeSwitchPosition(t *testing.T) {
+	// Prepare test
+	c := FromString("6,001,100\n").TypeSwitch("Account", nachaTypes)
+
+	// Start test
+	_, err := c.ReadSwitch()
+	assert.Equal(t, "type switch: column Account not in csvutil.fileHeader", err.Error())
+
+	assert.Panic(t, func() { FromString("1\n").ReadSwitch() }, "csvutil: ReadSwitch called without TypeSwitch")
+}
@@ -0,0 +1,106 @@
+package csvutil
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// UnitConversion converts value to other unit.
+type UnitConversion func(value float64) float64
+
+// Unit conversions by "from->to" name.
+var (
+	unitsMu sync.RWMutex
+	units   = map[string]UnitConversion{
+		"lb->kg": func(v float64) float64 { return v * 0.45359237 },
+		"kg->lb": func(v float64) float64 { return v / 0.45359237 },
+		"oz->g":  func(v float64) float64 { return v * 28.349523125 },
+		"g->oz":  func(v float64) float64 { return v / 28.349523125 },
+		"in->cm": func(v float64) float64 { return v * 2.54 },
+		"cm->in": func(v float64) float64 { return v / 2.54 },
+		"ft->m":  func(v float64) float64 { return v * 0.3048 },
+		"m->ft":  func(v float64) float64 { return v / 0.3048 },
+		"mi->km": func(v float64) float64 { return v * 1.609344 },
+		"km->mi": func(v float64) float64 { return v / 1.609344 },
+		"F->C":   func(v float64) float64 { return (v - 32) * 5 / 9 },
+		"C->F":   func(v float64) float64 { return v*9/5 + 32 },
+	}
+)
+
+// RegisterUnit registers conversion of values from one unit to the other
+// so it can be used in unit tag option. Float fields tagged with unit option
+// hold values converted from the unit used in the CSV file to the one used
+// by the program. ToCsv and Rewrite convert them back, which needs the
+// reverse conversion to be registered.
+//
+// Example:
+//
+//	csvutil.RegisterUnit("st", "kg", func(v float64) float64 { return v * 6.35029318 })
+//	csvutil.RegisterUnit("kg", "st", func(v float64) float64 { return v / 6.35029318 })
+//
+//	type parcel struct {
+//		Weight float64 `csv:"weight,unit=st->kg"`
+//	}
+func RegisterUnit(from, to string, fn UnitConversion) {
+	unitsMu.Lock()
+	defer unitsMu.Unlock()
+	units[from+"->"+to] = fn
+}
+
+// unitConversion returns conversion registered under "from->to" spec.
+func unitConversion(spec string) (UnitConversion, bool) {
+	unitsMu.RLock()
+	defer unitsMu.RUnlock()
+	fn, ok := units[spec]
+	return fn, ok
+}
+
+// checkUnit panics if the unit option can't be used on field f.
+func checkUnit(f reflect.StructField, spec string) {
+	t := f.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Float32 && t.Kind() != reflect.Float64 {
+		panic("Field " + f.Name + ": unit option requires float type")
+	}
+	if _, ok := unitConversion(spec); !ok {
+		panic("Field " + f.Name + ": unknown unit conversion " + spec)
+	}
+}
+
+// applyUnit converts value of the float field elem set from CSV.
+func applyUnit(elem reflect.Value, spec string) {
+	if elem.Kind() == reflect.Ptr {
+		if elem.IsNil() {
+			return
+		}
+		elem = elem.Elem()
+	}
+	fn, _ := unitConversion(spec)
+	elem.SetFloat(fn(elem.Float()))
+}
+
+// unitOut converts value written to CSV back to the unit used in the file.
+func unitOut(spec, value string) (string, error) {
+	parts := strings.SplitN(spec, "->", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("invalid unit conversion %s", spec)
+	}
+	back := parts[1] + "->" + parts[0]
+	fn, ok := unitConversion(back)
+	if !ok {
+		return "", fmt.Errorf("unknown unit conversion %s", back)
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return "", err
+	}
+	// Round to 12 significant digits to hide floating point noise
+	// of converting the value there and back.
+	f, _ = strconv.ParseFloat(strconv.FormatFloat(fn(f), 'g', 12, 64), 64)
+	return strconv.FormatFloat(f, 'f', -1, 64), nil
+}
@@ -0,0 +1,74 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"strconv"
+	"testing"
+)
+
+type parcel struct {
+	Name   string
+	Weight float64 `csv:"weight,unit=lb->kg"`
+	Length float64 `csv:"length,unit=in->cm"`
+}
+
+func Test_Unit(t *testing.T) {
+	// Prepare test
+	c := FromString("Box,10,2\n")
+
+	// Start test
+	got := &parcel{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, "4.53592", strconv.FormatFloat(got.Weight, 'f', 5, 64))
+	assert.Equal(t, 5.08, got.Length)
+
+	assert.Equal(t, "Box,10,2", ToCsv(got, ",", "T", "F"))
+
+	got.Length = 10.16
+	record, err := c.Rewrite(got)
+	assert.NotError(t, err)
+	assert.Equal(t, []string{"Box", "10", "4"}, record)
+}
+
+func Test_UnitPointer(t *testing.T) {
+	// Prepare test
+	type optionalParcel struct {
+		Name   string
+		Length *float64 `csv:"length,unit=in->cm"`
+	}
+
+	// Start test
+	got := &optionalParcel{}
+	assert.NotError(t, FromString("Box,\n").SetData(got))
+	assert.Equal(t, (*float64)(nil), got.Length)
+	assert.NotError(t, FromString("Box,1\n").SetData(got))
+	assert.Equal(t, 2.54, *got.Length)
+}
+
+func Test_RegisterUnit(t *testing.T) {
+	// Prepare test
+	RegisterUnit("dozen", "pcs", func(v float64) float64 { return v * 12 })
+	type eggs struct {
+		Count float32 `csv:"count,unit=dozen->pcs"`
+	}
+
+	// Start test
+	got := &eggs{}
+	assert.NotError(t, FromString("2\n").SetData(got))
+	assert.Equal(t, float32(24), got.Count)
+	assert.Panic(t, func() { ToCsv(got, ",", "T", "F") }, "Expected panic without reverse conversion")
+}
+
+func Test_UnitTagPanic(t *testing.T) {
+	// Prepare test
+	type badUnit struct {
+		Count int `csv:"count,unit=lb->kg"`
+	}
+	type unknownUnit struct {
+		Weight float64 `csv:"weight,unit=st->kg"`
+	}
+
+	// Start test
+	assert.Panic(t, func() { FromString("1").SetData(&badUnit{}) }, "Expected panic for int field")
+	assert.Panic(t, func() { FromString("1").SetData(&unknownUnit{}) }, "Expected panic for unknown conversion")
+}
@@ -0,0 +1,243 @@
+package csvutil
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// URLOptions configures OpenURL.
+type URLOptions struct {
+	Client  *http.Client  // HTTP client, http.DefaultClient when nil
+	Header  http.Header   // Additional request headers
+	Timeout time.Duration // Time to wait for response headers, no limit when zero
+	Retries int           // Number of retries of failed or interrupted requests
+	Backoff time.Duration // Delay before the first retry, doubled with every retry
+}
+
+// StatusError is returned by OpenURL when server responds with unexpected status.
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected HTTP status %d for %s", e.StatusCode, e.URL)
+}
+
+// ErrResourceChanged is returned by OpenURL streams when resumed download
+// gets different version of the resource than the one read so far.
+var ErrResourceChanged = errors.New("resource changed while resuming download")
+
+// retryable returns true if request may succeed when repeated.
+func (e *StatusError) retryable() bool {
+	return e.StatusCode >= 500 || e.StatusCode == http.StatusTooManyRequests
+}
+
+// OpenURL returns stream of the resource at url suitable for NewCsvUtil.
+// Redirects are followed, gzip Content-Encoding is decoded and interrupted
+// downloads are resumed with Range requests up to opts.Retries times.
+// The opts may be nil.
+func OpenURL(ctx context.Context, url string, opts *URLOptions) (io.ReadCloser, error) {
+	u := &urlReader{ctx: ctx, url: url}
+	if opts != nil {
+		u.opts = *opts
+	}
+	if u.opts.Client == nil {
+		u.opts.Client = http.DefaultClient
+	}
+
+	if err := u.open(); err != nil {
+		return nil, err
+	}
+
+	if !u.gzip {
+		return u, nil
+	}
+
+	gr, err := gzip.NewReader(u)
+	if err != nil {
+		u.Close()
+		return nil, err
+	}
+	return &decompressReader{ReadCloser: gr, src: u}, nil
+}
+
+// urlReader reads HTTP resource resuming the download after failures.
+type urlReader struct {
+	ctx     context.Context
+	url     string
+	opts    URLOptions
+	body    io.ReadCloser
+	cancel  context.CancelFunc
+	offset  int64  // Number of bytes read so far
+	etag    string // Validator of the resource, used when resuming
+	lastMod string // Last-Modified of the resource, used when there is no ETag
+	gzip    bool   // True if resource is gzip encoded
+	retries int    // Number of retries done so far
+}
+
+// open requests the resource from current offset retrying on failures.
+func (u *urlReader) open() error {
+	for {
+		err := u.request()
+		if err == nil {
+			return nil
+		}
+
+		if se, ok := err.(*StatusError); ok && !se.retryable() {
+			return err
+		}
+		if err == ErrResourceChanged {
+			return err
+		}
+		if u.ctx.Err() != nil || u.retries >= u.opts.Retries {
+			return err
+		}
+
+		wait := u.opts.Backoff << uint(u.retries)
+		u.retries++
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-u.ctx.Done():
+			timer.Stop()
+			return u.ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// request sends single request for the resource starting at current offset.
+func (u *urlReader) request() error {
+	ctx, cancel := context.WithCancel(u.ctx)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.url, nil)
+	if err != nil {
+		cancel()
+		return err
+	}
+	for k, v := range u.opts.Header {
+		req.Header[k] = v
+	}
+	// Asking for gzip explicitly stops the transport from decoding
+	// it transparently which would make byte offsets meaningless.
+	req.Header.Set("Accept-Encoding", "gzip")
+	if u.offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(u.offset, 10)+"-")
+		if u.etag != "" {
+			req.Header.Set("If-Range", u.etag)
+		} else if u.lastMod != "" {
+			req.Header.Set("If-Range", u.lastMod)
+		}
+	}
+
+	var timer *time.Timer
+	if u.opts.Timeout > 0 {
+		timer = time.AfterFunc(u.opts.Timeout, cancel)
+	}
+	resp, err := u.opts.Client.Do(req)
+	if timer != nil && !timer.Stop() && err == nil {
+		resp.Body.Close()
+		err = context.DeadlineExceeded
+	}
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	skip := int64(0)
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && u.offset > 0:
+		if !strings.HasPrefix(resp.Header.Get("Content-Range"), "bytes "+strconv.FormatInt(u.offset, 10)+"-") {
+			resp.Body.Close()
+			cancel()
+			return fmt.Errorf("unexpected Content-Range %q for %s", resp.Header.Get("Content-Range"), u.url)
+		}
+	case resp.StatusCode == http.StatusOK:
+		// Server sent the whole resource. It's the one we started with,
+		// and the data we already have may be skipped, only if it has
+		// the same validator.
+		if u.offset > 0 && !u.sameResource(resp) {
+			resp.Body.Close()
+			cancel()
+			return ErrResourceChanged
+		}
+		skip = u.offset
+	default:
+		resp.Body.Close()
+		cancel()
+		return &StatusError{URL: u.url, StatusCode: resp.StatusCode}
+	}
+
+	if u.offset == 0 {
+		u.etag = resp.Header.Get("ETag")
+		u.lastMod = resp.Header.Get("Last-Modified")
+		u.gzip = resp.Header.Get("Content-Encoding") == "gzip"
+	}
+
+	if skip > 0 {
+		if _, err = io.CopyN(io.Discard, resp.Body, skip); err != nil {
+			resp.Body.Close()
+			cancel()
+			return err
+		}
+	}
+
+	u.body = resp.Body
+	u.cancel = cancel
+	return nil
+}
+
+// sameResource returns true if the response has validators of the resource
+// read so far. Resources without validators can't be compared and are
+// assumed to be the same.
+func (u *urlReader) sameResource(resp *http.Response) bool {
+	if u.etag != "" {
+		return resp.Header.Get("ETag") == u.etag
+	}
+	if u.lastMod != "" {
+		return resp.Header.Get("Last-Modified") == u.lastMod
+	}
+	return true
+}
+
+func (u *urlReader) Read(p []byte) (int, error) {
+	for {
+		n, err := u.body.Read(p)
+		u.offset += int64(n)
+		if err == nil || err == io.EOF || u.ctx.Err() != nil {
+			return n, err
+		}
+
+		// Download interrupted, try to resume it.
+		u.close()
+		if u.retries >= u.opts.Retries {
+			return n, err
+		}
+		u.retries++
+		if oerr := u.open(); oerr != nil {
+			return n, oerr
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// close closes current response.
+func (u *urlReader) close() error {
+	err := u.body.Close()
+	u.cancel()
+	return err
+}
+
+func (u *urlReader) Close() error {
+	return u.close()
+}
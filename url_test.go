@@ -0,0 +1,133 @@
+package csvutil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/rzajac/goassert/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testCsvData = strings.Repeat("Tony|23|123.456|Y\n", 100)
+
+func Test_OpenURL(t *testing.T) {
+	// Prepare test
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/data" {
+			http.Redirect(w, r, "/data", http.StatusFound)
+			return
+		}
+		io.WriteString(w, testCsvData)
+	}))
+	defer srv.Close()
+
+	// Start test
+	rc, err := OpenURL(context.Background(), srv.URL, nil)
+	assert.NotError(t, err)
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	assert.NotError(t, err)
+	assert.Equal(t, testCsvData, string(data))
+}
+
+func Test_OpenURLGzip(t *testing.T) {
+	// Prepare test
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	io.WriteString(gw, testCsvData)
+	gw.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(buf.Bytes())
+	}))
+	defer srv.Close()
+
+	// Start test
+	rc, err := OpenURL(context.Background(), srv.URL, nil)
+	assert.NotError(t, err)
+	defer rc.Close()
+
+	c := NewCsvUtil(rc).Comma('|').CustomBool([]string{"Y"}, []string{"N"})
+	p := &person{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, "Tony", p.Name)
+}
+
+func Test_OpenURLResume(t *testing.T) {
+	// Prepare test
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			// Promise the whole body but send only half of it.
+			w.Header().Set("Content-Length", "1800")
+			io.WriteString(w, testCsvData[:900])
+			return
+		}
+		http.ServeContent(w, r, "data.csv", time.Time{}, strings.NewReader(testCsvData))
+	}))
+	defer srv.Close()
+
+	// Start test
+	rc, err := OpenURL(context.Background(), srv.URL, &URLOptions{Retries: 2})
+	assert.NotError(t, err)
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	assert.NotError(t, err)
+	assert.Equal(t, testCsvData, string(data))
+	assert.Equal(t, 2, requests)
+}
+
+func Test_OpenURLResumeChanged(t *testing.T) {
+	// Prepare test
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Content-Length", "1800")
+			io.WriteString(w, testCsvData[:900])
+			return
+		}
+		assert.Equal(t, `"v1"`, r.Header.Get("If-Range"))
+		w.Header().Set("ETag", `"v2"`)
+		io.WriteString(w, testCsvData)
+	}))
+	defer srv.Close()
+
+	// Start test
+	rc, err := OpenURL(context.Background(), srv.URL, &URLOptions{Retries: 2})
+	assert.NotError(t, err)
+	defer rc.Close()
+
+	_, err = io.ReadAll(rc)
+	assert.Equal(t, ErrResourceChanged, err)
+	assert.Equal(t, 2, requests)
+}
+
+func Test_OpenURLStatus(t *testing.T) {
+	// Prepare test
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	// Start test
+	_, err := OpenURL(context.Background(), srv.URL, &URLOptions{Retries: 5})
+	assert.Equal(t, &StatusError{URL: srv.URL, StatusCode: http.StatusNotFound}, err)
+	assert.Equal(t, 3, requests)
+}
@@ -0,0 +1,202 @@
+package csvutil
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// rule is a single constraint from the validate struct tag.
+type rule struct {
+	name string
+	arg  string
+	re   *regexp.Regexp
+	num  float64
+}
+
+// ruleNames lists rules which may be used in validate tag.
+var ruleNames = map[string]bool{
+	"regex":  true,
+	"minlen": true,
+	"maxlen": true,
+	"min":    true,
+	"max":    true,
+}
+
+// parseRules parses validate struct tag.
+//
+// Example:
+//
+//	`validate:"regex=^[A-Z]{2}$,maxlen=64,min=0,max=100"`
+//	`validate:"minlen=1,email"`
+func parseRules(tag string) ([]rule, error) {
+	var rules []rule
+
+	for _, part := range splitRules(tag) {
+		kv := strings.SplitN(part, "=", 2)
+		ru := rule{name: kv[0]}
+		if len(kv) == 2 {
+			ru.arg = kv[1]
+		}
+
+		var err error
+		switch ru.name {
+		case "regex":
+			ru.re, err = regexp.Compile(ru.arg)
+		case "minlen", "maxlen", "min", "max":
+			ru.num, err = strconv.ParseFloat(ru.arg, 64)
+		default:
+			if formatRule(ru.name) == nil {
+				err = errors.New("unknown rule")
+			} else if len(kv) == 2 {
+				err = errors.New("rule takes no argument")
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid validate rule %q: %v", part, err)
+		}
+		rules = append(rules, ru)
+	}
+
+	return rules, nil
+}
+
+// splitRules splits validate tag on commas which are followed by a rule name
+// so the commas can be used in regular expressions.
+func splitRules(tag string) []string {
+	var parts []string
+	if tag == "" {
+		return parts
+	}
+
+	start := 0
+	for i := 0; i < len(tag); i++ {
+		if tag[i] != ',' {
+			continue
+		}
+		name := strings.SplitN(tag[i+1:], "=", 2)[0]
+		if isRule(strings.SplitN(name, ",", 2)[0]) {
+			parts = append(parts, tag[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, tag[start:])
+}
+
+// check returns error if value does not satisfy the rule.
+func (ru *rule) check(value string) error {
+	switch ru.name {
+	case "regex":
+		if !ru.re.MatchString(value) {
+			return fmt.Errorf("does not match %s", ru.arg)
+		}
+	case "minlen":
+		if float64(utf8.RuneCountInString(value)) < ru.num {
+			return fmt.Errorf("shorter than %s", ru.arg)
+		}
+	case "maxlen":
+		if float64(utf8.RuneCountInString(value)) > ru.num {
+			return fmt.Errorf("longer than %s", ru.arg)
+		}
+	case "min", "max":
+		if value == "" {
+			return nil
+		}
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		if ru.name == "min" && num < ru.num {
+			return fmt.Errorf("less than %s", ru.arg)
+		}
+		if ru.name == "max" && num > ru.num {
+			return fmt.Errorf("greater than %s", ru.arg)
+		}
+	default:
+		if value == "" {
+			return nil
+		}
+		return formatRule(ru.name)(value)
+	}
+	return nil
+}
+
+// validate checks the column value against rules of the structure field.
+func (r *Reader) validate(f *sField, value string) error {
+	for i := range f.rules {
+		if err := f.rules[i].check(value); err != nil {
+			return r.fieldError(f, value, err)
+		}
+	}
+	if set, ok := r.sets[f.name]; ok {
+		if _, ok = set[value]; !ok {
+			return r.fieldError(f, value, errors.New("not in allowed set"))
+		}
+	}
+	return nil
+}
+
+// valueSet is a set of allowed field values.
+type valueSet map[string]struct{}
+
+// FieldInSet makes SetData report values of the field not present in set.
+func (r *Reader) FieldInSet(field string, set map[string]struct{}) *Reader {
+	if r.sets == nil {
+		r.sets = make(map[string]valueSet)
+	}
+	r.sets[field] = set
+	return r
+}
+
+// LoadSet returns set of values from the column of all remaining records
+// read by r. It's meant for loading sets for FieldInSet from lookup files.
+func LoadSet(r *Reader, column int) (map[string]struct{}, error) {
+	set := make(map[string]struct{})
+	for {
+		record, err := r.read()
+		if err == io.EOF {
+			return set, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if column < len(record) {
+			set[record[column]] = struct{}{}
+		}
+	}
+}
+
+// RowValidator checks structure populated from CSV record number row.
+// Used for constraints involving multiple fields.
+type RowValidator func(row int, v interface{}) error
+
+// ValidateRow adds validator run by SetData after all fields are set.
+// Errors returned by validators are reported as *RowError.
+//
+// Example:
+//
+//	c.ValidateRow(func(row int, v interface{}) error {
+//		if p := v.(*period); p.End.Before(p.Start) {
+//			return errors.New("End before Start")
+//		}
+//		return nil
+//	})
+func (r *Reader) ValidateRow(fn RowValidator) *Reader {
+	r.rowChecks = append(r.rowChecks, fn)
+	return r
+}
+
+// validateRow runs row validators on v.
+func (r *Reader) validateRow(v interface{}) error {
+	for _, fn := range r.rowChecks {
+		if err := fn(r.row, v); err != nil {
+			return &RowError{Row: r.row, Err: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package csvutil
+
+import (
+	"errors"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type validated struct {
+	Country string `validate:"regex=^[A-Z]{2,3}$,maxlen=3"`
+	Name    string `validate:"minlen=2,maxlen=5"`
+	Score   int    `validate:"min=0,max=100"`
+}
+
+func Test_splitRules(t *testing.T) {
+	assert.Equal(t, []string{"regex=^[A-Z]{2,3}$", "maxlen=3"}, splitRules("regex=^[A-Z]{2,3}$,maxlen=3"))
+	assert.Equal(t, []string{"min=0", "max=100"}, splitRules("min=0,max=100"))
+	assert.Equal(t, []string(nil), splitRules(""))
+}
+
+func Test_parseRules(t *testing.T) {
+	_, err := parseRules("regex=(")
+	assert.NotNil(t, err)
+
+	_, err = parseRules("maxlen=x")
+	assert.NotNil(t, err)
+
+	_, err = parseRules("unknown=1")
+	assert.NotNil(t, err)
+}
+
+func Test_SetDataValidate(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("PL,Tony,50\npl,Tony,50\nUS,T,50\nUS,Tony,101")
+	c := NewCsvUtil(sr)
+
+	// Start test
+	v := &validated{}
+	assert.NotError(t, c.SetData(v))
+	assert.Equal(t, validated{"PL", "Tony", 50}, *v)
+
+	err := c.SetData(v)
+	assert.Equal(t, &FieldError{Line: 2, Column: "Country", Value: "pl", Err: errors.New("does not match ^[A-Z]{2,3}$")}, err)
+
+	err = c.SetData(v)
+	assert.Equal(t, &FieldError{Line: 3, Column: "Name", Value: "T", Err: errors.New("shorter than 2")}, err)
+
+	err = c.SetData(v)
+	assert.Equal(t, "line 4, column Score: greater than 100", err.Error())
+}
+
+func Test_ValidateRow(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("1,5\n5,1")
+	c := NewCsvUtil(sr)
+
+	type period struct {
+		Start int
+		End   int
+	}
+
+	var rows []int
+	c.ValidateRow(func(row int, v interface{}) error {
+		rows = append(rows, row)
+		if p := v.(*period); p.End < p.Start {
+			return errors.New("End before Start")
+		}
+		return nil
+	})
+
+	// Start test
+	p := &period{}
+	assert.NotError(t, c.SetData(p))
+	assert.Equal(t, &RowError{Row: 2, Err: errors.New("End before Start")}, c.SetData(p))
+	assert.Equal(t, []int{1, 2}, rows)
+}
+
+func Test_FieldInSet(t *testing.T) {
+	// Prepare test
+	countries, err := LoadSet(NewCsvUtil(NewStringReadCloser("PL,Poland\nUS,United States")), 0)
+	assert.NotError(t, err)
+	assert.Equal(t, map[string]struct{}{"PL": {}, "US": {}}, countries)
+
+	sr := NewStringReadCloser("PL,Tony,50\nDE,John,50")
+	c := NewCsvUtil(sr).FieldInSet("Country", countries)
+
+	// Start test
+	v := &validated{}
+	assert.NotError(t, c.SetData(v))
+	assert.Equal(t, &FieldError{Line: 2, Column: "Country", Value: "DE", Err: errors.New("not in allowed set")}, c.SetData(v))
+}
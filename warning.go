@@ -0,0 +1,67 @@
+package csvutil
+
+import (
+	"sort"
+	"strings"
+)
+
+// Warning describes non-fatal finding made while decoding a record.
+type Warning struct {
+	Line    int    // Line in the CSV file
+	Column  string // Column name, empty if the warning is about the record
+	Value   string // The column value
+	Message string // What has been found
+}
+
+// OnWarning sets function receiving warnings: values coerced to the field
+// type and columns ignored because no field is mapped to them. The latter
+// is reported once, for the first decoded record. Warnings never stop
+// decoding, errors are returned by SetData as usual.
+//
+// Example:
+//
+//	c := NewCsvUtil(f).HeaderFromFirstRow().OnWarning(func(w csvutil.Warning) {
+//		log.Printf("line %d, column %s: %s", w.Line, w.Column, w.Message)
+//	})
+func (r *Reader) OnWarning(fn func(Warning)) *Reader {
+	r.onWarning = fn
+	return r
+}
+
+// warn reports warning about the column value of the current record.
+func (r *Reader) warn(col, value, msg string) {
+	if r.onWarning == nil {
+		return
+	}
+	line, _ := r.fieldPos(0)
+	r.onWarning(Warning{Line: line, Column: col, Value: value, Message: msg})
+}
+
+// warnExtra reports columns of the current record no field is mapped to.
+func (r *Reader) warnExtra(fields []*sField) {
+	r.warnedExtra = true
+
+	used := make(map[int]bool, len(fields))
+	for _, f := range fields {
+		if idx, ok := r.header[f.col]; ok {
+			used[idx] = true
+		}
+	}
+
+	var extra []int
+	for i := range r.csvLine {
+		if !used[i] {
+			extra = append(extra, i)
+		}
+	}
+	if len(extra) == 0 {
+		return
+	}
+	sort.Ints(extra)
+
+	names := make([]string, len(extra))
+	for i, idx := range extra {
+		names[i] = r.columnName(idx)
+	}
+	r.warn("", "", "columns ignored: "+strings.Join(names, ", "))
+}
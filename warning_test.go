@@ -0,0 +1,41 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type warned struct {
+	Name string
+	Age  int
+}
+
+func Test_OnWarning(t *testing.T) {
+	// Prepare test
+	var warnings []Warning
+	c := FromString("Name,Email,Age\nTony,t@x.com,1_000\nJohn,j@x.com,2e1\n").
+		HeaderFromFirstRow().
+		LenientNumbers(true).
+		OnWarning(func(w Warning) { warnings = append(warnings, w) })
+
+	// Start test
+	got := &warned{}
+	assert.NotError(t, c.SetData(got))
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, warned{"John", 20}, *got)
+	assert.Equal(t, []Warning{
+		{Line: 2, Message: "columns ignored: Email"},
+		{Line: 2, Column: "Age", Value: "1_000", Message: "coerced to 1000"},
+		{Line: 3, Column: "Age", Value: "2e1", Message: "coerced to 20"},
+	}, warnings)
+}
+
+func Test_OnWarningNoHeader(t *testing.T) {
+	// Prepare test
+	var warnings []Warning
+	c := FromString("Tony,23,x,y\n").FieldsPerRecord(-1).OnWarning(func(w Warning) { warnings = append(warnings, w) })
+
+	// Start test
+	assert.NotError(t, c.SetData(&warned{}))
+	assert.Equal(t, []Warning{{Line: 1, Message: "columns ignored: #3, #4"}}, warnings)
+}
@@ -0,0 +1,123 @@
+package csvutil
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// Whitespace describes what happens to spaces around CSV values.
+type Whitespace int
+
+const (
+	// WhitespacePreserve keeps values exactly as they are in the file.
+	WhitespacePreserve Whitespace = iota
+	// WhitespaceTrimUnquoted trims leading and trailing spaces of values
+	// which were not quoted. Quoted values are never changed.
+	WhitespaceTrimUnquoted
+	// WhitespaceTrimAll trims leading and trailing spaces of all values.
+	WhitespaceTrimAll
+)
+
+// TrimLeadingSpace ignores leading white space in a field (default: false).
+// Quoted values are not affected.
+func (r *Reader) TrimLeadingSpace(b bool) *Reader {
+	r.csvr.TrimLeadingSpace = b
+	return r
+}
+
+// Whitespace sets whitespace policy for the values (default: WhitespacePreserve).
+// WhitespacePreserve also disables TrimLeadingSpace and Trim.
+// Must be called before the first record is read.
+//
+// Example:
+//
+//	// Trim spaces around values but keep " quoted " as is.
+//	NewCsvUtil(sr).Whitespace(WhitespaceTrimUnquoted)
+func (r *Reader) Whitespace(p Whitespace) *Reader {
+	r.whitespace = p
+	switch p {
+	case WhitespacePreserve:
+		r.csvr.TrimLeadingSpace = false
+		r.trim = ""
+	case WhitespaceTrimUnquoted:
+		r.csvr.TrimLeadingSpace = true
+		r.tapInput()
+	case WhitespaceTrimAll:
+		r.csvr.TrimLeadingSpace = true
+	}
+	return r
+}
+
+// trimSpace applies whitespace policy to the value of the field i.
+func (r *Reader) trimSpace(i int, value string) string {
+	switch r.whitespace {
+	case WhitespaceTrimUnquoted:
+		if r.quoted(i) {
+			return value
+		}
+	case WhitespaceTrimAll:
+	default:
+		return value
+	}
+	return strings.TrimSpace(value)
+}
+
+// tapInput starts keeping raw input of the current record so it can be
+// told which values were quoted.
+func (r *Reader) tapInput() {
+	if r.tap == nil {
+		r.tap = &rawTap{line: 1}
+		r.wrap(func(src io.Reader) io.Reader {
+			r.tap.src = src
+			return r.tap
+		})
+	}
+}
+
+// quoted returns true if the field i of the current record was quoted.
+func (r *Reader) quoted(i int) bool {
+	if r.tap == nil {
+		return false
+	}
+	line, col := r.csvr.FieldPos(i)
+	return r.tap.at(line, col) == '"'
+}
+
+// rawTap keeps raw bytes of the input from the start of the current record.
+type rawTap struct {
+	src  io.Reader
+	buf  []byte
+	base int64 // Input offset of buf[0]
+	line int   // Line number of buf[0]
+}
+
+func (t *rawTap) Read(p []byte) (int, error) {
+	n, err := t.src.Read(p)
+	t.buf = append(t.buf, p[:n]...)
+	return n, err
+}
+
+// discard drops bytes before input offset off.
+func (t *rawTap) discard(off int64) {
+	n := int(off - t.base)
+	t.line += bytes.Count(t.buf[:n], []byte{'\n'})
+	t.buf = append(t.buf[:0], t.buf[n:]...)
+	t.base = off
+}
+
+// at returns byte at line and column (both 1 based) or 0 if there is none.
+func (t *rawTap) at(line, col int) byte {
+	i := 0
+	for l := t.line; l < line; l++ {
+		j := bytes.IndexByte(t.buf[i:], '\n')
+		if j < 0 {
+			return 0
+		}
+		i += j + 1
+	}
+	if col < 1 || i+col > len(t.buf) {
+		return 0
+	}
+	return t.buf[i+col-1]
+}
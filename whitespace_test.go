@@ -0,0 +1,56 @@
+package csvutil
+
+import (
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type spaced struct {
+	Name string
+	City string
+	Code string
+}
+
+func Test_WhitespacePolicy(t *testing.T) {
+	// Prepare test
+	line := "  Tony , \" New York \",A1 \n"
+	tt := []struct {
+		policy Whitespace
+		exp    spaced
+	}{
+		{WhitespacePreserve, spaced{"  Tony ", " \" New York \"", "A1 "}},
+		{WhitespaceTrimUnquoted, spaced{"Tony", " New York ", "A1"}},
+		{WhitespaceTrimAll, spaced{"Tony", "New York", "A1"}},
+	}
+
+	// Start test
+	for _, tc := range tt {
+		c := NewCsvUtil(NewStringReadCloser(line)).LazyQuotes(true).Whitespace(tc.policy)
+		got := &spaced{}
+		assert.NotError(t, c.SetData(got))
+		assert.Equal(t, tc.exp, *got)
+	}
+}
+
+func Test_WhitespaceMultiline(t *testing.T) {
+	// Prepare test
+	sr := NewStringReadCloser("# comment\n a ,\"b\nc \", d\n\" e \",f , g\n")
+	c := NewCsvUtil(sr).Comment('#').Whitespace(WhitespaceTrimUnquoted)
+
+	// Start test
+	got := &spaced{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, spaced{"a", "b\nc ", "d"}, *got)
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, spaced{" e ", "f", "g"}, *got)
+}
+
+func Test_TrimLeadingSpace(t *testing.T) {
+	// Prepare test
+	c := NewCsvUtil(NewStringReadCloser(" Tony, \" x\", y ")).TrimLeadingSpace(true)
+
+	// Start test
+	got := &spaced{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, spaced{"Tony", " x", "y "}, *got)
+}
@@ -0,0 +1,74 @@
+package csvutil
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Truncation describes value shortened to fit into the column.
+type Truncation struct {
+	Column string // Column name
+	Length int    // Length of the original value
+	MaxLen int    // Length value was truncated to
+}
+
+// ToCsvReport works like ToCsv but returns error instead of panicking and
+// reports values which have been truncated to fit into their columns.
+//
+// Columns written to consumers with field size limits are tagged with maxlen
+// option. Longer values make ToCsv fail unless onoverflow=truncate is set.
+// The limit is in characters and applies to the plain value, after unit,
+// cents and pseudonymize conversions but before encryption and id quoting.
+// Encrypted and id columns can't be truncated, cutting them would make the
+// value impossible to decrypt or match.
+//
+// Example:
+//
+//	type product struct {
+//		Name string `csv:"name,maxlen=255,onoverflow=truncate"`
+//		SKU  string `csv:"sku,maxlen=12"` // Error when longer
+//	}
+func ToCsvReport(v interface{}, delim, boolTrue, boolFalse string) (string, []Truncation, error) {
+	var report []Truncation
+	line, err := toCsv(v, delim, boolTrue, boolFalse, &report)
+	if err != nil {
+		return "", nil, err
+	}
+	return line, report, nil
+}
+
+// limitWidth applies maxlen and onoverflow options to the value.
+func limitWidth(col string, opts tagOptions, value string, report *[]Truncation) (string, error) {
+	max, ok := opts.get("maxlen")
+	if !ok {
+		return value, nil
+	}
+	maxLen, err := strconv.Atoi(max)
+	if err != nil || maxLen < 0 {
+		return "", fmt.Errorf("invalid maxlen %q", max)
+	}
+
+	mode, _ := opts.get("onoverflow")
+	if _, id := opts.get("id"); mode == "truncate" && (id || opts.has("encrypted")) {
+		return "", errors.New("onoverflow=truncate not allowed on encrypted or id column")
+	}
+
+	length := utf8.RuneCountInString(value)
+	if length <= maxLen {
+		return value, nil
+	}
+
+	switch mode {
+	case "", "error":
+		return "", fmt.Errorf("value of %d characters exceeds maxlen %d", length, maxLen)
+	case "truncate":
+		if report != nil {
+			*report = append(*report, Truncation{Column: col, Length: length, MaxLen: maxLen})
+		}
+		return string([]rune(value)[:maxLen]), nil
+	default:
+		return "", fmt.Errorf("invalid onoverflow %q", mode)
+	}
+}
@@ -0,0 +1,87 @@
+package csvutil
+
+import (
+	"bytes"
+	"github.com/rzajac/goassert/assert"
+	"testing"
+)
+
+type product struct {
+	Name string `csv:"name,maxlen=5,onoverflow=truncate"`
+	SKU  string `csv:"sku,maxlen=3"`
+}
+
+func Test_ToCsvReport(t *testing.T) {
+	// Start test
+	line, report, err := ToCsvReport(&product{Name: "Żółta kaczka", SKU: "A1"}, ",", "T", "F")
+	assert.NotError(t, err)
+	assert.Equal(t, "Żółta,A1", line)
+	assert.Equal(t, []Truncation{{Column: "name", Length: 12, MaxLen: 5}}, report)
+
+	_, _, err = ToCsvReport(&product{Name: "Duck", SKU: "A123"}, ",", "T", "F")
+	assert.Equal(t, "Wasn't able to get value for field: SKU: value of 4 characters exceeds maxlen 3", err.Error())
+
+	assert.Panic(t, func() { ToCsv(&product{SKU: "A123"}, ",", "T", "F") }, "Expected ToCsv to panic on overflow")
+}
+
+func Test_limitWidth(t *testing.T) {
+	// Start test
+	_, err := limitWidth("a", tagOptions{"maxlen=x"}, "abc", nil)
+	assert.NotNil(t, err)
+	_, err = limitWidth("a", tagOptions{"maxlen=1", "onoverflow=drop"}, "abc", nil)
+	assert.NotNil(t, err)
+	value, err := limitWidth("a", tagOptions{"maxlen=1", "onoverflow=truncate"}, "abc", nil)
+	assert.NotError(t, err)
+	assert.Equal(t, "a", value)
+}
+
+func Test_ToCsvReportWrittenWidth(t *testing.T) {
+	// Prepare test
+	type priced struct {
+		Price int64 `csv:"price,cents,maxlen=4"`
+	}
+
+	// Start test
+	line, _, err := ToCsvReport(&priced{Price: 99}, ",", "T", "F")
+	assert.NotError(t, err)
+	assert.Equal(t, "0.99", line)
+
+	_, _, err = ToCsvReport(&priced{Price: 1999}, ",", "T", "F")
+	assert.Equal(t, "Wasn't able to get value for field: Price: value of 5 characters exceeds maxlen 4", err.Error())
+}
+
+func Test_WidthWritersAgree(t *testing.T) {
+	// Prepare test
+	type code struct {
+		Number string `csv:"number,id,maxlen=5"`
+		Name   string `csv:"name,maxlen=3,onoverflow=truncate"`
+	}
+	rows := []code{{Number: "00123", Name: "Tony"}}
+	var buf bytes.Buffer
+
+	// Start test
+	line, report, err := ToCsvReport(&rows[0], ",", "T", "F")
+	assert.NotError(t, err)
+	assert.Equal(t, `"00123",Ton`, line)
+	assert.Equal(t, []Truncation{{Column: "name", Length: 4, MaxLen: 3}}, report)
+
+	_, err = NewExport(rows, Dialect{}).WriteTo(&buf)
+	assert.NotError(t, err)
+	assert.Equal(t, "00123,Ton\n", buf.String())
+
+	c := NewCsvUtil(NewStringReadCloser(line))
+	got := &code{}
+	assert.NotError(t, c.SetData(got))
+	assert.Equal(t, "00123", got.Number)
+}
+
+func Test_WidthTruncateProtected(t *testing.T) {
+	// Prepare test
+	type code struct {
+		Number string `csv:"number,id,maxlen=5,onoverflow=truncate"`
+	}
+
+	// Start test
+	_, _, err := ToCsvReport(&code{Number: "00123"}, ",", "T", "F")
+	assert.Equal(t, "Wasn't able to get value for field: Number: onoverflow=truncate not allowed on encrypted or id column", err.Error())
+}